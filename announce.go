@@ -0,0 +1,90 @@
+package skeleton
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// announcementsLimit is the maximum number of announcements kept by GetAnnouncements.
+const announcementsLimit = 100
+
+// announcementTTL is the time an announcement is shown on the status line.
+const announcementTTL = 5 * time.Second
+
+// announcer is hold the announcements stream of the screen-reader mode.
+type announcer struct {
+	mu           sync.Mutex
+	enabled      bool
+	writer       io.Writer
+	onStatusLine bool
+	history      []string
+}
+
+// SetScreenReaderMode enables or disables the screen-reader mode. Announcements are only
+// emitted in screen-reader mode.
+func (s *Skeleton) SetScreenReaderMode(enabled bool) *Skeleton {
+	s.announcer.mu.Lock()
+	s.announcer.enabled = enabled
+	s.announcer.mu.Unlock()
+	return s
+}
+
+// IsScreenReaderMode returns the screen-reader mode is enabled or not.
+func (s *Skeleton) IsScreenReaderMode() bool {
+	s.announcer.mu.Lock()
+	defer s.announcer.mu.Unlock()
+	return s.announcer.enabled
+}
+
+// SetAnnouncementWriter sets the writer which receives the announcements, one per line.
+// It should not be the terminal the application is drawn on. Nil disables it.
+func (s *Skeleton) SetAnnouncementWriter(w io.Writer) *Skeleton {
+	s.announcer.mu.Lock()
+	s.announcer.writer = w
+	s.announcer.mu.Unlock()
+	return s
+}
+
+// SetAnnouncementsOnStatusLine sets the announcements are also shown as status message or not.
+func (s *Skeleton) SetAnnouncementsOnStatusLine(enabled bool) *Skeleton {
+	s.announcer.mu.Lock()
+	s.announcer.onStatusLine = enabled
+	s.announcer.mu.Unlock()
+	return s
+}
+
+// GetAnnouncements returns the last announcements, oldest first.
+func (s *Skeleton) GetAnnouncements() []string {
+	s.announcer.mu.Lock()
+	defer s.announcer.mu.Unlock()
+	out := make([]string, len(s.announcer.history))
+	copy(out, s.announcer.history)
+	return out
+}
+
+// Announce emits the given text to the announcements stream in screen-reader mode, so dynamic
+// changes are perceivable without visual scanning. It is safe to call from goroutines.
+func (s *Skeleton) Announce(text string) *Skeleton {
+	s.announcer.mu.Lock()
+	if !s.announcer.enabled || text == "" {
+		s.announcer.mu.Unlock()
+		return s
+	}
+
+	s.announcer.history = append(s.announcer.history, text)
+	if len(s.announcer.history) > announcementsLimit {
+		s.announcer.history = s.announcer.history[len(s.announcer.history)-announcementsLimit:]
+	}
+	if s.announcer.writer != nil {
+		_, _ = fmt.Fprintln(s.announcer.writer, text)
+	}
+	onStatusLine := s.announcer.onStatusLine
+	s.announcer.mu.Unlock()
+
+	if onStatusLine {
+		s.SetStatusMessage(text, announcementTTL)
+	}
+	return s
+}
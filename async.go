@@ -0,0 +1,65 @@
+package skeleton
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// runMsg is hold a function which is run on the update loop of the Skeleton, e.g. a throttled widget value.
+type runMsg struct {
+	fn func()
+}
+
+// Context returns the context of the Skeleton, it is cancelled when the application shuts down.
+func (s *Skeleton) Context() context.Context {
+	return s.ctx
+}
+
+// Go runs fn in a new goroutine and delivers the message it returns to all pages, nil is not delivered.
+// The context is cancelled when the application shuts down. The pages handle the message on the update
+// loop, so they can change their state and the Skeleton without racing with the rendering. The message is
+// not dropped when the update loop falls behind, the goroutine waits for it until the application shuts down.
+func (s *Skeleton) Go(fn func(ctx context.Context) tea.Msg) *Skeleton {
+	ctx := s.ctx
+	go func() {
+		defer s.restoreOnPanic()
+		if msg := fn(ctx); msg != nil && ctx.Err() == nil {
+			s.sendBroadcast(ctx, msg)
+		}
+	}()
+	return s
+}
+
+// Every calls fn every d and delivers the messages it returns to all pages, like Go, until the application
+// shuts down. Calls which take longer than d delay the next ones instead of piling up.
+func (s *Skeleton) Every(d time.Duration, fn func(ctx context.Context) tea.Msg) *Skeleton {
+	if d <= 0 {
+		return s
+	}
+
+	ctx := s.ctx
+	ticker := s.clock.NewTicker(d)
+	go func() {
+		defer s.restoreOnPanic()
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C():
+				if msg := fn(ctx); msg != nil && ctx.Err() == nil {
+					s.sendBroadcast(ctx, msg)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return s
+}
+
+// sendBroadcast delivers the given message to all pages like broadcast, but waits for room in the buffer of
+// the updater instead of dropping the message, until ctx is done. It is used by the goroutines of the Skeleton.
+func (s *Skeleton) sendBroadcast(ctx context.Context, msg tea.Msg) {
+	s.updater.sendWithMsg(ctx, broadcastMsg{msg: msg})
+}
@@ -0,0 +1,306 @@
+package skeleton
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// testMsg is the message delivered to the pages by the tests.
+type testMsg struct {
+	n int
+}
+
+// probeMsg runs fn on the update loop, it is handled by testPage.
+type probeMsg struct {
+	fn func()
+}
+
+// testPage records the testMsgs it receives and handles them with handle on the update loop.
+type testPage struct {
+	received chan testMsg
+	handle   func(testMsg)
+}
+
+func newTestPage() *testPage {
+	return &testPage{received: make(chan testMsg, 1024)}
+}
+
+func (p *testPage) Init() tea.Cmd { return nil }
+
+func (p *testPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case testMsg:
+		if p.handle != nil {
+			p.handle(msg)
+		}
+		p.received <- msg
+	case probeMsg:
+		msg.fn()
+	}
+	return p, nil
+}
+
+func (p *testPage) View() string { return "test page" }
+
+// runTestProgram runs the Skeleton in a program without a terminal until the test ends.
+func runTestProgram(t *testing.T, s *Skeleton) *tea.Program {
+	t.Helper()
+
+	p := tea.NewProgram(s, tea.WithInput(nil), tea.WithOutput(io.Discard), tea.WithoutRenderer(), tea.WithoutSignalHandler())
+	s.SetProgram(p)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = p.Run()
+		s.Shutdown()
+	}()
+	t.Cleanup(func() {
+		p.Quit()
+		<-done
+	})
+
+	p.Send(tea.WindowSizeMsg{Width: 80, Height: 24})
+	return p
+}
+
+// onLoop runs fn on the update loop of the program and waits for it.
+func onLoop(p *tea.Program, fn func()) {
+	done := make(chan struct{})
+	p.Send(probeMsg{fn: func() {
+		fn()
+		close(done)
+	}})
+	<-done
+}
+
+// eventually waits until cond, which is checked on the update loop, returns true.
+func eventually(t *testing.T, p *tea.Program, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		var ok bool
+		onLoop(p, func() { ok = cond() })
+		if ok {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// receive returns the next message received by the page.
+func receive(t *testing.T, page *testPage) testMsg {
+	t.Helper()
+
+	select {
+	case msg := <-page.received:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the message")
+		return testMsg{}
+	}
+}
+
+// widgetValue returns the value of the widget by the given key, it has to be called on the update loop.
+func widgetValue(s *Skeleton, key string) string {
+	if wgt := s.widget.GetWidget(key); wgt != nil {
+		return wgt.Value
+	}
+	return ""
+}
+
+func TestGoDeliversToAllPages(t *testing.T) {
+	s := NewSkeleton()
+	first, second := newTestPage(), newTestPage()
+	s.AddPage("first", "First", first)
+	s.AddPage("second", "Second", second)
+	runTestProgram(t, s)
+
+	s.Go(func(ctx context.Context) tea.Msg {
+		return testMsg{n: 1}
+	})
+
+	if msg := receive(t, first); msg.n != 1 {
+		t.Errorf("first page received %d, want 1", msg.n)
+	}
+	if msg := receive(t, second); msg.n != 1 {
+		t.Errorf("second page received %d, want 1", msg.n)
+	}
+}
+
+func TestGoDoesNotDropMessages(t *testing.T) {
+	s := NewSkeleton()
+	page := newTestPage()
+	s.AddPage("page", "Page", page)
+
+	// the messages are sent before the program runs, more than the buffer of the updater holds
+	const count = 600
+	for i := range count {
+		s.Go(func(ctx context.Context) tea.Msg {
+			return testMsg{n: i}
+		})
+	}
+	runTestProgram(t, s)
+
+	seen := make(map[int]bool, count)
+	for range count {
+		seen[receive(t, page).n] = true
+	}
+	if len(seen) != count {
+		t.Errorf("received %d distinct messages, want %d", len(seen), count)
+	}
+}
+
+func TestEveryTicksWithTheClock(t *testing.T) {
+	clock := NewSimulatedClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	s := NewSkeleton().SetClock(clock)
+	page := newTestPage()
+	s.AddPage("page", "Page", page)
+	runTestProgram(t, s)
+
+	var calls int
+	s.Every(time.Second, func(ctx context.Context) tea.Msg {
+		calls++
+		return testMsg{n: calls}
+	})
+
+	for want := 1; want <= 3; want++ {
+		clock.Advance(time.Second)
+		if msg := receive(t, page); msg.n != want {
+			t.Errorf("tick %d delivered %d", want, msg.n)
+		}
+	}
+}
+
+func TestEveryUpdatesWidgetsOnTheUpdateLoop(t *testing.T) {
+	clock := NewSimulatedClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	s := NewSkeleton().SetClock(clock)
+	page := newTestPage()
+	// like the system monitor example, the page applies the stats read by Every to the widgets
+	page.handle = func(msg testMsg) {
+		s.UpdateWidgetValue("cpu", fmt.Sprintf("CPU %d%%", msg.n))
+	}
+	s.AddPage("page", "Page", page)
+	s.AddWidget("cpu", "CPU ?")
+	p := runTestProgram(t, s)
+
+	s.Every(time.Second, func(ctx context.Context) tea.Msg {
+		return testMsg{n: 42}
+	})
+	clock.Advance(time.Second)
+	receive(t, page)
+
+	eventually(t, p, func() bool {
+		return widgetValue(s, "cpu") == "CPU 42%"
+	})
+}
+
+func TestDynamicWidgetRefreshesWithTheClock(t *testing.T) {
+	clock := NewSimulatedClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	s := NewSkeleton().SetClock(clock)
+	s.AddPage("page", "Page", newTestPage())
+
+	var mu sync.Mutex
+	var refreshes int
+	s.AddDynamicWidget("procs", 5*time.Second, func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		refreshes++
+		return fmt.Sprintf("Procs: %d", refreshes)
+	})
+	p := runTestProgram(t, s)
+
+	eventually(t, p, func() bool {
+		return widgetValue(s, "procs") == "Procs: 1"
+	})
+	eventually(t, p, func() bool {
+		clock.Advance(5 * time.Second)
+		return widgetValue(s, "procs") != "Procs: 1"
+	})
+}
+
+func TestUpdateWidgetValueThrottled(t *testing.T) {
+	clock := NewSimulatedClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	s := NewSkeleton().SetClock(clock)
+	s.AddPage("page", "Page", newTestPage())
+	s.AddWidget("w", "start")
+	p := runTestProgram(t, s)
+
+	for i := range 300 {
+		s.UpdateWidgetValueThrottled("w", fmt.Sprintf("v%d", i), time.Second)
+	}
+	eventually(t, p, func() bool {
+		return widgetValue(s, "w") == "v0"
+	})
+
+	clock.Advance(time.Second)
+	eventually(t, p, func() bool {
+		return widgetValue(s, "w") == "v299"
+	})
+
+	// the interval elapses without updates, the key is forgotten
+	clock.Advance(time.Second)
+	s.throttler.mu.Lock()
+	entries := len(s.throttler.entries)
+	s.throttler.mu.Unlock()
+	if entries != 0 {
+		t.Errorf("throttler keeps %d keys, want 0", entries)
+	}
+}
+
+func TestUpdateWidgetValueThrottledFromGoroutines(t *testing.T) {
+	clock := NewSimulatedClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	s := NewSkeleton().SetClock(clock)
+	s.AddPage("page", "Page", newTestPage())
+	const workers = 8
+	for w := range workers {
+		s.AddWidget(fmt.Sprintf("w%d", w), "start")
+	}
+	p := runTestProgram(t, s)
+
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 100 {
+				s.UpdateWidgetValueThrottled(fmt.Sprintf("w%d", w), fmt.Sprintf("v%d", i), 100*time.Millisecond)
+			}
+		}()
+	}
+
+	stop := make(chan struct{})
+	advanced := make(chan struct{})
+	go func() {
+		defer close(advanced)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				clock.Advance(10 * time.Millisecond)
+			}
+		}
+	}()
+	wg.Wait()
+	close(stop)
+	<-advanced
+
+	clock.Advance(time.Second)
+	for w := range workers {
+		key := fmt.Sprintf("w%d", w)
+		eventually(t, p, func() bool {
+			return widgetValue(s, key) == "v99"
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package skeleton
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Clock is the source of time of the Skeleton. Timers, tickers, expiring status messages and toasts,
+// throttling, file watching and animated widgets all use it, so a SimulatedClock makes them deterministic.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// AfterFunc calls f once d elapses. f may be called on any goroutine, possibly synchronously,
+	// e.g. by the goroutine which advances a simulated time, so the callers must not hold the locks f takes.
+	AfterFunc(d time.Duration, f func()) ClockTimer
+	// NewTicker returns a ticker which sends the time on its channel every d.
+	NewTicker(d time.Duration) ClockTicker
+}
+
+// ClockTimer is a timer created by a Clock.
+type ClockTimer interface {
+	// Stop prevents the timer from firing. It returns false if the timer already fired or was stopped.
+	Stop() bool
+}
+
+// ClockTicker is a ticker created by a Clock.
+type ClockTicker interface {
+	// C returns the channel which the ticks are delivered on.
+	C() <-chan time.Time
+	// Stop turns off the ticker.
+	Stop()
+}
+
+// SystemClock returns the Clock backed by the real time. It is the default Clock of the Skeleton.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+// systemClock is the Clock backed by the time package.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) AfterFunc(d time.Duration, f func()) ClockTimer {
+	return time.AfterFunc(d, f)
+}
+
+func (systemClock) NewTicker(d time.Duration) ClockTicker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+// systemTicker adapts time.Ticker to ClockTicker.
+type systemTicker struct {
+	*time.Ticker
+}
+
+func (t systemTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
+
+// SimulatedClock is a Clock whose time only moves when Advance or Set is called.
+// It is meant for tests of time-based features: timers and tickers fire in order while the time is advanced.
+type SimulatedClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	seq    int
+	events []*simulatedEvent
+}
+
+// simulatedEvent is hold a pending timer or ticker of the SimulatedClock.
+type simulatedEvent struct {
+	clock  *SimulatedClock
+	seq    int
+	at     time.Time
+	period time.Duration
+	fn     func()
+	c      chan time.Time
+}
+
+// NewSimulatedClock returns a new SimulatedClock starting at the given time.
+func NewSimulatedClock(start time.Time) *SimulatedClock {
+	return &SimulatedClock{now: start}
+}
+
+// Now returns the current simulated time.
+func (c *SimulatedClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// AfterFunc calls f once the simulated time is advanced by d.
+func (c *SimulatedClock) AfterFunc(d time.Duration, f func()) ClockTimer {
+	return simulatedTimer{c.schedule(d, 0, f, nil)}
+}
+
+// NewTicker returns a ticker which ticks every time the simulated time is advanced by d.
+// Like time.Ticker, ticks are dropped if the receiver falls behind.
+func (c *SimulatedClock) NewTicker(d time.Duration) ClockTicker {
+	if d <= 0 {
+		panic("skeleton: non-positive interval for NewTicker")
+	}
+	return simulatedTicker{c.schedule(d, d, nil, make(chan time.Time, 1))}
+}
+
+// Advance moves the simulated time forward by d and fires the timers and tickers which became due, in order.
+// Timer functions are called synchronously, before Advance returns.
+func (c *SimulatedClock) Advance(d time.Duration) {
+	c.Set(c.Now().Add(d))
+}
+
+// Set moves the simulated time to t, firing the timers and tickers which became due, in order.
+// Moving the time backwards fires nothing.
+func (c *SimulatedClock) Set(t time.Time) {
+	for {
+		c.mu.Lock()
+		if len(c.events) == 0 || c.events[0].at.After(t) {
+			if t.After(c.now) {
+				c.now = t
+			}
+			c.mu.Unlock()
+			return
+		}
+
+		e := c.events[0]
+		c.now = e.at
+		if e.period > 0 {
+			e.at = e.at.Add(e.period)
+			c.sort()
+		} else {
+			c.events = c.events[1:]
+		}
+		now := c.now
+		c.mu.Unlock()
+
+		if e.c != nil {
+			select {
+			case e.c <- now:
+			default:
+			}
+		}
+		if e.fn != nil {
+			e.fn()
+		}
+	}
+}
+
+// schedule registers a new event due after d.
+func (c *SimulatedClock) schedule(d, period time.Duration, fn func(), ch chan time.Time) *simulatedEvent {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.seq++
+	e := &simulatedEvent{clock: c, seq: c.seq, at: c.now.Add(d), period: period, fn: fn, c: ch}
+	c.events = append(c.events, e)
+	c.sort()
+	return e
+}
+
+// sort orders the pending events by their due time, then by their creation.
+func (c *SimulatedClock) sort() {
+	sort.SliceStable(c.events, func(i, j int) bool {
+		if c.events[i].at.Equal(c.events[j].at) {
+			return c.events[i].seq < c.events[j].seq
+		}
+		return c.events[i].at.Before(c.events[j].at)
+	})
+}
+
+// cancel removes the event from its clock. It returns false if the event already fired or was cancelled.
+func (e *simulatedEvent) cancel() bool {
+	c := e.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, pending := range c.events {
+		if pending == e {
+			c.events = append(c.events[:i], c.events[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// simulatedTimer is a ClockTimer of the SimulatedClock.
+type simulatedTimer struct {
+	event *simulatedEvent
+}
+
+func (t simulatedTimer) Stop() bool {
+	return t.event.cancel()
+}
+
+// simulatedTicker is a ClockTicker of the SimulatedClock.
+type simulatedTicker struct {
+	event *simulatedEvent
+}
+
+func (t simulatedTicker) C() <-chan time.Time {
+	return t.event.c
+}
+
+func (t simulatedTicker) Stop() {
+	t.event.cancel()
+}
+
+// SetClock sets the Clock of the Skeleton, SystemClock is used if c is nil.
+// It should be set before adding pages, widgets and timers, the ones already running keep their clock.
+func (s *Skeleton) SetClock(c Clock) *Skeleton {
+	if c == nil {
+		c = SystemClock()
+	}
+	s.clock = c
+	s.widget.clock = c
+	s.throttler.mu.Lock()
+	s.throttler.clock = c
+	s.throttler.mu.Unlock()
+	s.watchers.mu.Lock()
+	s.watchers.clock = c
+	s.watchers.mu.Unlock()
+	s.updater.Update()
+	return s
+}
+
+// GetClock returns the Clock of the Skeleton.
+func (s *Skeleton) GetClock() Clock {
+	return s.clock
+}
+
+// clockTick returns a command which returns msg once d elapses on the clock of the Skeleton.
+func (s *Skeleton) clockTick(d time.Duration, msg tea.Msg) tea.Cmd {
+	clock := s.clock
+	return func() tea.Msg {
+		fired := make(chan struct{})
+		clock.AfterFunc(d, func() { close(fired) })
+		<-fired
+		return msg
+	}
+}
@@ -0,0 +1,64 @@
+package skeleton
+
+import (
+	"maps"
+	"slices"
+)
+
+// Clone returns a new Skeleton with a deep copy of the configuration of s: the properties, the themes,
+// the styles and the layout of the header and the footer, the key bindings, the handlers and the permissions.
+// The pages, the widgets and the runtime state are not copied, and nothing is shared between the Skeletons,
+// so serving code can configure a template once and stamp out a Skeleton for every session.
+func (s *Skeleton) Clone() *Skeleton {
+	c := NewSkeleton()
+
+	properties := *s.properties
+	c.properties = &properties
+
+	headerProperties := *s.header.properties
+	c.header.properties = &headerProperties
+	c.header.renderer = s.header.renderer
+	c.header.rightContent = s.header.rightContent
+	c.header.compact = s.header.compact
+	c.header.hidden = s.header.hidden
+	c.header.newTabButton = s.header.newTabButton
+	c.header.allowedTabs = maps.Clone(s.header.allowedTabs)
+
+	widgetProperties := *s.widget.properties
+	c.widget.properties = &widgetProperties
+	c.widget.renderer = s.widget.renderer
+	c.widget.compact = s.widget.compact
+	c.widget.rowCount = s.widget.rowCount
+	c.widget.position = s.widget.position
+	c.widget.hidden = s.widget.hidden
+	c.widget.statusRow = s.widget.statusRow
+	c.widget.sanitize = s.widget.sanitize
+	c.widget.overflowPolicy = s.widget.overflowPolicy
+	c.widget.historySize = s.widget.historySize
+
+	// the header keeps the pointer of the key bindings, they are copied in place
+	*c.KeyMap = *s.KeyMap
+	c.KeyMap.JumpToTab = slices.Clone(s.KeyMap.JumpToTab)
+
+	c.themes = slices.Clone(s.themes)
+	c.currentTheme = s.currentTheme
+	c.viewProcessors = slices.Clone(s.viewProcessors)
+
+	c.newTabHandler = s.newTabHandler
+	c.tabDoubleClickAction = s.tabDoubleClickAction
+	c.onQuitRequested = s.onQuitRequested
+	c.onCloseRequested = s.onCloseRequested
+	c.interactionSink = s.interactionSink
+	c.SetClock(s.clock)
+	c.quitKeyDisabled = s.quitKeyDisabled
+	c.closedPagesLimit = s.closedPagesLimit
+
+	c.permissions = s.permissions
+	c.permissions.AllowedTabs = slices.Clone(s.permissions.AllowedTabs)
+	c.pageLimit.max = s.pageLimit.max
+	c.pageLimit.policy = s.pageLimit.policy
+	c.frameTimer.budget = s.frameTimer.budget
+	c.frameTimer.threshold = s.frameTimer.threshold
+
+	return c
+}
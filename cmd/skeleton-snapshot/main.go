@@ -0,0 +1,126 @@
+// Command skeleton-snapshot renders a reference layout under a set of themes at several terminal
+// sizes and compares the renders with golden files, to catch styling regressions of the header and
+// the widgets.
+//
+//	go run ./cmd/skeleton-snapshot -dir testdata/snapshots -update   # write the golden files
+//	go run ./cmd/skeleton-snapshot -dir testdata/snapshots           # compare with the golden files
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/termkit/skeleton"
+)
+
+// referencePage is a static page of the reference layout.
+type referencePage struct {
+	content string
+}
+
+func (p referencePage) Init() tea.Cmd                       { return nil }
+func (p referencePage) Update(tea.Msg) (tea.Model, tea.Cmd) { return p, nil }
+func (p referencePage) View() string                        { return p.content }
+
+// referenceThemes are the themes the reference layout is rendered with.
+var referenceThemes = []skeleton.Theme{
+	{
+		Name:                   "default",
+		BorderColor:            "39",
+		ActiveTabTextColor:     "255",
+		ActiveTabBorderColor:   "205",
+		InactiveTabTextColor:   "255",
+		InactiveTabBorderColor: "255",
+		WidgetBorderColor:      "49",
+	},
+	{
+		Name:                   "gruvbox",
+		BorderColor:            "214",
+		ActiveTabTextColor:     "223",
+		ActiveTabBorderColor:   "142",
+		InactiveTabTextColor:   "246",
+		InactiveTabBorderColor: "241",
+		WidgetBorderColor:      "142",
+	},
+	{
+		Name:                   "mono",
+		BorderColor:            "250",
+		ActiveTabTextColor:     "255",
+		ActiveTabBorderColor:   "255",
+		InactiveTabTextColor:   "244",
+		InactiveTabBorderColor: "240",
+		WidgetBorderColor:      "250",
+	},
+}
+
+func main() {
+	dir := flag.String("dir", "testdata/snapshots", "directory of the golden files")
+	update := flag.Bool("update", false, "write the golden files instead of comparing")
+	sizes := flag.String("sizes", "", "comma separated sizes, e.g. 80x24,120x30 (default 60x16,80x24,120x30)")
+	flag.Parse()
+
+	parsedSizes, err := parseSizes(*sizes)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	s := referenceLayout()
+	diffs, err := s.SnapshotThemes(*dir, parsedSizes, *update)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	for _, diff := range diffs {
+		fmt.Println(diff)
+	}
+	if len(diffs) > 0 {
+		fmt.Printf("%d snapshot(s) differ, run with -update if the change is intended\n", len(diffs))
+		os.Exit(1)
+	}
+}
+
+// referenceLayout returns the Skeleton the snapshots are rendered from.
+func referenceLayout() *skeleton.Skeleton {
+	s := skeleton.NewSkeleton()
+	s.AddPage("first", "First Tab", referencePage{content: "first page"})
+	s.AddPage("second", "Second Tab", referencePage{content: "second page"})
+	s.AddPage("third", "Third Tab", referencePage{content: "third page"})
+	s.AddWidget("battery", "Battery %92")
+	s.AddWidget("time", "12:00:00")
+
+	for _, theme := range referenceThemes {
+		s.RegisterTheme(theme)
+	}
+	return s
+}
+
+// parseSizes parses the comma separated sizes of the -sizes flag.
+func parseSizes(value string) ([]skeleton.Size, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	var sizes []skeleton.Size
+	for _, part := range strings.Split(value, ",") {
+		width, height, ok := strings.Cut(strings.TrimSpace(part), "x")
+		if !ok {
+			return nil, fmt.Errorf("invalid size %q, expected WIDTHxHEIGHT", part)
+		}
+		w, err := strconv.Atoi(width)
+		if err != nil {
+			return nil, fmt.Errorf("invalid width in %q: %w", part, err)
+		}
+		h, err := strconv.Atoi(height)
+		if err != nil {
+			return nil, fmt.Errorf("invalid height in %q: %w", part, err)
+		}
+		sizes = append(sizes, skeleton.Size{Width: w, Height: h})
+	}
+	return sizes, nil
+}
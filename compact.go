@@ -0,0 +1,115 @@
+package skeleton
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// compactHeaderView renders the tabs embedded into the top border of the frame, on a single line.
+// The tabs which do not fit are cut off, the active tab is kept visible by starting from it.
+func (h *header) compactHeaderView() string {
+	h.hitBoxes = h.hitBoxes[:0]
+	frame := h.properties.glyphs.Frame
+	borderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(h.properties.borderColor))
+
+	width := max(h.viewport.Width-2, 0)
+	var b strings.Builder
+	used, x := 0, 1
+	for _, i := range h.compactTabs(width) {
+		label := " " + h.renderCompactTab(i) + " "
+		labelWidth := ansi.StringWidth(label)
+		if used+1+labelWidth > width {
+			break
+		}
+		b.WriteString(borderStyle.Render(frame.Top))
+		b.WriteString(label)
+		h.hitBoxes = append(h.hitBoxes, headerHitBox{index: i, start: x + 1, end: x + 1 + labelWidth})
+		used += 1 + labelWidth
+		x += 1 + labelWidth
+	}
+
+	return borderStyle.Render(frame.TopLeft) + b.String() + h.fillLine(width-used) + borderStyle.Render(frame.TopRight)
+}
+
+// compactTabs returns the visible tabs, starting late enough to keep the active tab within the given width.
+func (h *header) compactTabs(width int) []int {
+	tabs := h.sidebarTabs()
+	// every tab takes the line before it and a space on both sides
+	tabWidth := func(i int) int {
+		return 3 + ansi.StringWidth(h.tabLabel(h.headers[i]))
+	}
+
+	active := slices.Index(tabs, h.currentTab)
+	if active < 0 {
+		return tabs
+	}
+	start, used := active, tabWidth(tabs[active])
+	for start > 0 && used+tabWidth(tabs[start-1]) <= width {
+		start--
+		used += tabWidth(tabs[start])
+	}
+	return tabs[start:]
+}
+
+// renderCompactTab renders the label of the tab at the given index with the style of its state.
+func (h *header) renderCompactTab(i int) string {
+	hdr := h.headers[i]
+	label := h.tabLabel(hdr)
+	switch {
+	case i == h.currentTab:
+		return h.properties.titleStyleActive.UnsetBorderStyle().UnsetPadding().Bold(true).Render(label)
+	case h.isTabUnavailable(hdr.key):
+		return h.properties.titleStyleUnavailable.UnsetBorderStyle().UnsetPadding().Render(label)
+	case h.GetLockTabs() || h.IsTabLocked(hdr.key):
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Render(label)
+	default:
+		return h.properties.titleStyleInactive.UnsetBorderStyle().UnsetPadding().Faint(true).Render(label)
+	}
+}
+
+// compactFooterView renders the widgets embedded into the bottom border of the frame, on a single line.
+// The widgets are right aligned, the status message or the tab description is shown on the left.
+func (w *widget) compactFooterView() string {
+	w.hitBoxes = nil
+	frame := w.properties.glyphs.Frame
+	borderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(w.properties.borderColor))
+
+	width := max(w.viewport.Width-2, 0)
+	if w.statusBar != nil {
+		bar := w.statusBar.Render(max(width-1, 0))
+		line := w.renderLine(width - lipgloss.Width(bar))
+		return borderStyle.Render(frame.BottomLeft) + line + bar + borderStyle.Render(frame.BottomRight)
+	}
+
+	var values []string
+	used := 0
+	shown := w.shownWidgets()
+	for _, wgt := range shown {
+		value := " " + truncateText(w.constrainWidget(wgt).Value, max(width-used-3, 0)) + " "
+		if used+1+ansi.StringWidth(value) > width-1 {
+			break
+		}
+		values = append(values, value)
+		used += 1 + ansi.StringWidth(value)
+	}
+
+	// widgets are placed after the line, x starts after the left corner and the line
+	line := w.renderLine(width - used)
+	x := 1 + lipgloss.Width(line)
+	var b strings.Builder
+	for i, value := range values {
+		b.WriteString(borderStyle.Render(frame.Bottom))
+		x++
+		valueWidth := ansi.StringWidth(value)
+		key := shown[i].Key
+		w.hitBoxes = append(w.hitBoxes, widgetHitBox{key: key, start: x, end: x + valueWidth})
+		focused := w.focusedWidget >= 0 && w.focusedWidget < len(w.widgets) && w.widgets[w.focusedWidget].Key == key
+		b.WriteString(w.highlight(key, value, focused))
+		x += valueWidth
+	}
+
+	return borderStyle.Render(frame.BottomLeft) + line + b.String() + borderStyle.Render(frame.BottomRight)
+}
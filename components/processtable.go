@@ -0,0 +1,308 @@
+// Package components contains ready made pages which can be added to a Skeleton.
+package components
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/termkit/skeleton"
+)
+
+// Process is a row of the ProcessTablePage.
+type Process struct {
+	PID    int32
+	Name   string
+	User   string
+	CPU    float64
+	Memory float64
+}
+
+// ProcessSource returns the current processes, it is called on every refresh.
+type ProcessSource func() ([]Process, error)
+
+// SignalHandler is called when the user sends a signal to the selected process.
+// The handler is responsible for delivering the signal, e.g. with os.FindProcess and Signal.
+type SignalHandler func(process Process, signal os.Signal) tea.Cmd
+
+// ProcessColumn is a sortable column of the ProcessTablePage.
+type ProcessColumn int
+
+// The sortable columns of the ProcessTablePage.
+const (
+	ColumnPID ProcessColumn = iota
+	ColumnName
+	ColumnUser
+	ColumnCPU
+	ColumnMemory
+
+	// processColumnCount is the number of the columns
+	processColumnCount
+)
+
+// processColumnTitles are hold the titles of the columns by their ProcessColumn.
+var processColumnTitles = [processColumnCount]string{"PID", "Name", "User", "CPU%", "MEM%"}
+
+// processRefreshMsg is sent by the skeleton ticker to refresh the processes.
+type processRefreshMsg struct{}
+
+// processKeyMap is hold the key bindings of the ProcessTablePage.
+type processKeyMap struct {
+	Sort      key.Binding
+	Reverse   key.Binding
+	Terminate key.Binding
+	Kill      key.Binding
+}
+
+// ShortHelp returns the key bindings of the page, it implements help.KeyMap.
+func (k processKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Sort, k.Reverse, k.Terminate, k.Kill}
+}
+
+// FullHelp returns the key bindings of the page, it implements help.KeyMap.
+func (k processKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+// ProcessTablePage is a page which lists processes in a table. The processes are refreshed from
+// the source by a skeleton ticker, so the table is updated even while the page is not active.
+type ProcessTablePage struct {
+	skeleton *skeleton.Skeleton
+	key      string
+	source   ProcessSource
+	onSignal SignalHandler
+
+	table     table.Model
+	keys      processKeyMap
+	processes []Process
+	err       error
+
+	sortBy     ProcessColumn
+	descending bool
+}
+
+// NewProcessTablePage returns a new ProcessTablePage which will be added by the given key.
+// Start has to be called after the page is added to refresh the processes.
+func NewProcessTablePage(s *skeleton.Skeleton, key string, source ProcessSource) *ProcessTablePage {
+	p := &ProcessTablePage{
+		skeleton:   s,
+		key:        key,
+		source:     source,
+		table:      table.New(table.WithFocused(true)),
+		keys:       newProcessKeyMap(),
+		sortBy:     ColumnCPU,
+		descending: true,
+	}
+	p.table.SetColumns(p.columns(0))
+	s.RegisterPageKeyMap(key, p.keys)
+	return p
+}
+
+// newProcessKeyMap returns the default key bindings of the ProcessTablePage.
+func newProcessKeyMap() processKeyMap {
+	return processKeyMap{
+		Sort: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "sort by next column"),
+		),
+		Reverse: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "reverse sort"),
+		),
+		Terminate: key.NewBinding(
+			key.WithKeys("x"),
+			key.WithHelp("x", "terminate process"),
+		),
+		Kill: key.NewBinding(
+			key.WithKeys("X"),
+			key.WithHelp("X", "kill process"),
+		),
+	}
+}
+
+// Start refreshes the processes now and then every interval until the page is deleted.
+func (p *ProcessTablePage) Start(interval time.Duration) *ProcessTablePage {
+	p.refresh()
+	p.skeleton.Ticker(p.key, interval, processRefreshMsg{})
+	return p
+}
+
+// SetSignalHandler sets the handler which is called when the user terminates or kills the selected
+// process. Without a handler the terminate and kill keys do nothing.
+func (p *ProcessTablePage) SetSignalHandler(handler SignalHandler) *ProcessTablePage {
+	p.onSignal = handler
+	return p
+}
+
+// SetSort sets the column the processes are sorted by and the direction.
+func (p *ProcessTablePage) SetSort(column ProcessColumn, descending bool) *ProcessTablePage {
+	if column < 0 || column >= processColumnCount {
+		return p
+	}
+	selected, ok := p.SelectedProcess()
+	p.sortBy = column
+	p.descending = descending
+	p.updateRows(selected.PID, ok)
+	return p
+}
+
+// GetSort returns the column the processes are sorted by and the direction.
+func (p *ProcessTablePage) GetSort() (ProcessColumn, bool) {
+	return p.sortBy, p.descending
+}
+
+// SelectedProcess returns the process under the cursor, false if there are no processes.
+func (p *ProcessTablePage) SelectedProcess() (Process, bool) {
+	cursor := p.table.Cursor()
+	if cursor < 0 || cursor >= len(p.processes) {
+		return Process{}, false
+	}
+	return p.processes[cursor], true
+}
+
+func (p *ProcessTablePage) Init() tea.Cmd {
+	return nil
+}
+
+func (p *ProcessTablePage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case processRefreshMsg:
+		p.refresh()
+		return p, nil
+	case tea.WindowSizeMsg, skeleton.IAMActivePage:
+		p.resize()
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, p.keys.Sort):
+			p.SetSort((p.sortBy+1)%processColumnCount, p.descending)
+			return p, nil
+		case key.Matches(msg, p.keys.Reverse):
+			p.SetSort(p.sortBy, !p.descending)
+			return p, nil
+		case key.Matches(msg, p.keys.Terminate):
+			return p, p.signal(syscall.SIGTERM)
+		case key.Matches(msg, p.keys.Kill):
+			return p, p.signal(syscall.SIGKILL)
+		}
+	}
+
+	var cmd tea.Cmd
+	p.table, cmd = p.table.Update(msg)
+	return p, cmd
+}
+
+func (p *ProcessTablePage) View() string {
+	if p.err != nil {
+		return fmt.Sprintf("failed to list processes: %v", p.err)
+	}
+	return p.table.View()
+}
+
+// signal calls the signal handler with the selected process.
+func (p *ProcessTablePage) signal(sig os.Signal) tea.Cmd {
+	process, ok := p.SelectedProcess()
+	if !ok || p.onSignal == nil {
+		return nil
+	}
+	return p.onSignal(process, sig)
+}
+
+// refresh reads the processes from the source and updates the table.
+func (p *ProcessTablePage) refresh() {
+	processes, err := p.source()
+	p.err = err
+	if err != nil {
+		return
+	}
+	selected, ok := p.SelectedProcess()
+	p.processes = processes
+	p.updateRows(selected.PID, ok)
+	p.skeleton.TriggerUpdate()
+}
+
+// resize fits the table into the content area of the skeleton.
+func (p *ProcessTablePage) resize() {
+	width := p.skeleton.GetContentWidth()
+	p.table.SetColumns(p.columns(width))
+	p.table.SetWidth(width)
+	p.table.SetHeight(max(p.skeleton.GetContentHeight()-1, 1))
+}
+
+// columns returns the columns of the table with the sort indicator, the name column takes the remaining width.
+func (p *ProcessTablePage) columns(width int) []table.Column {
+	widths := [processColumnCount]int{8, 0, 12, 7, 7}
+	fixed := 0
+	for _, w := range widths {
+		fixed += w + 2 // for the cell padding
+	}
+	widths[ColumnName] = max(width-fixed-2, 16)
+
+	columns := make([]table.Column, processColumnCount)
+	for i, title := range processColumnTitles {
+		if ProcessColumn(i) == p.sortBy {
+			if p.descending {
+				title += " ▼"
+			} else {
+				title += " ▲"
+			}
+		}
+		columns[i] = table.Column{Title: title, Width: widths[i]}
+	}
+	return columns
+}
+
+// updateRows sorts the processes and sets them as the rows of the table, the cursor follows the selected process.
+func (p *ProcessTablePage) updateRows(selectedPID int32, hasSelected bool) {
+
+	slices.SortStableFunc(p.processes, func(a, b Process) int {
+		c := compareProcesses(a, b, p.sortBy)
+		if c == 0 {
+			c = cmp.Compare(a.PID, b.PID)
+		} else if p.descending {
+			c = -c
+		}
+		return c
+	})
+
+	rows := make([]table.Row, len(p.processes))
+	cursor := 0
+	for i, process := range p.processes {
+		rows[i] = table.Row{
+			fmt.Sprint(process.PID),
+			process.Name,
+			process.User,
+			fmt.Sprintf("%.1f", process.CPU),
+			fmt.Sprintf("%.1f", process.Memory),
+		}
+		if hasSelected && process.PID == selectedPID {
+			cursor = i
+		}
+	}
+
+	p.table.SetColumns(p.columns(p.skeleton.GetContentWidth()))
+	p.table.SetRows(rows)
+	p.table.SetCursor(cursor)
+}
+
+// compareProcesses compares the processes by the given column.
+func compareProcesses(a, b Process, column ProcessColumn) int {
+	switch column {
+	case ColumnName:
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	case ColumnUser:
+		return strings.Compare(a.User, b.User)
+	case ColumnCPU:
+		return cmp.Compare(a.CPU, b.CPU)
+	case ColumnMemory:
+		return cmp.Compare(a.Memory, b.Memory)
+	default:
+		return cmp.Compare(a.PID, b.PID)
+	}
+}
@@ -0,0 +1,49 @@
+package skeleton
+
+import (
+	"fmt"
+)
+
+// MarkPageDirty marks the page as dirty (having unsaved changes) by the given key.
+// Dirty pages are not deleted directly, the user is asked to confirm first.
+func (s *Skeleton) MarkPageDirty(key string) *Skeleton {
+	key = s.normalizeKey(key)
+	s.dirtyPages[key] = true
+	s.updater.Update()
+	return s
+}
+
+// MarkPageClean removes the dirty mark of the page by the given key.
+func (s *Skeleton) MarkPageClean(key string) *Skeleton {
+	key = s.normalizeKey(key)
+	delete(s.dirtyPages, key)
+	s.updater.Update()
+	return s
+}
+
+// IsPageDirty returns the page is dirty or not.
+func (s *Skeleton) IsPageDirty(key string) bool {
+	key = s.normalizeKey(key)
+	return s.dirtyPages[key]
+}
+
+// requestClose asks the user to confirm closing the given dirty page.
+func (s *Skeleton) requestClose(key string) {
+	if s.pendingClose == key {
+		return
+	}
+	s.pendingClose = key
+
+	title := key
+	if i := s.pageIndex(key); i >= 0 {
+		title = s.header.headers[i].title
+	}
+
+	s.ShowConfirm("Unsaved changes", fmt.Sprintf("%q has unsaved changes.\nClose anyway?", title), func(confirmed bool) {
+		s.pendingClose = ""
+		if confirmed {
+			delete(s.dirtyPages, key)
+			s.DeletePage(key)
+		}
+	})
+}
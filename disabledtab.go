@@ -0,0 +1,95 @@
+package skeleton
+
+import (
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// blockedTabReasonTTL is how long the reason of a disabled or locked tab is shown after the user tries to switch to it.
+const blockedTabReasonTTL = 3 * time.Second
+
+// DisableTab disables the tab by the given key, e.g. when its feature is unavailable. A disabled tab is
+// greyed out and skipped while switching like a locked tab, but it is rendered with its own style.
+// The optional reason is shown on the footer line while the mouse is over the tab or when the user tries to switch to it.
+func (s *Skeleton) DisableTab(key string, reason ...string) *Skeleton {
+	key = s.normalizeKey(key)
+	s.header.disabledTabs[key] = ""
+	if len(reason) > 0 {
+		s.header.disabledTabs[key] = reason[0]
+	}
+	s.updater.Update()
+	return s
+}
+
+// EnableTab enables the tab by the given key again.
+func (s *Skeleton) EnableTab(key string) *Skeleton {
+	key = s.normalizeKey(key)
+	delete(s.header.disabledTabs, key)
+	s.updater.Update()
+	return s
+}
+
+// IsTabDisabled returns the tab by the given key is disabled or not.
+func (s *Skeleton) IsTabDisabled(key string) bool {
+	key = s.normalizeKey(key)
+	return s.header.IsTabDisabled(key)
+}
+
+// GetTabDisabledReason returns the reason the tab by the given key is disabled, it is empty if not set.
+func (s *Skeleton) GetTabDisabledReason(key string) string {
+	key = s.normalizeKey(key)
+	return s.header.disabledTabs[key]
+}
+
+// SetDisabledTabTextColor sets the text color of the disabled tabs.
+func (s *Skeleton) SetDisabledTabTextColor(color string) *Skeleton {
+	s.header.properties.titleStyleUnavailable = s.header.properties.titleStyleUnavailable.Foreground(lipgloss.Color(color))
+	s.updater.Update()
+	return s
+}
+
+// SetDisabledTabBorderColor sets the border color of the disabled tabs.
+func (s *Skeleton) SetDisabledTabBorderColor(color string) *Skeleton {
+	s.header.properties.titleStyleUnavailable = s.header.properties.titleStyleUnavailable.BorderForeground(lipgloss.Color(color))
+	s.updater.Update()
+	return s
+}
+
+// IsTabDisabled checks if a specific tab is disabled.
+func (h *header) IsTabDisabled(key string) bool {
+	_, ok := h.disabledTabs[key]
+	return ok
+}
+
+// canActivate returns true if the tab by the given key can be switched to, it is neither locked nor disabled,
+// and the permissions allow it.
+func (h *header) canActivate(key string) bool {
+	return !h.IsTabLocked(key) && !h.IsTabDisabled(key) && h.isTabAllowed(key)
+}
+
+// blockedReason returns the reason the tab at the given index can not be switched to: the reason it is
+// disabled, otherwise the reason it is locked. It is empty if there is no reason.
+func (h *header) blockedReason(i int) string {
+	if i < 0 || i >= len(h.headers) {
+		return ""
+	}
+	key := h.headers[i].key
+	if !h.isTabAllowed(key) {
+		return notPermittedReason
+	}
+	if reason := h.disabledTabs[key]; reason != "" {
+		return reason
+	}
+	if h.IsTabLocked(key) {
+		return h.lockReasons[key]
+	}
+	return ""
+}
+
+// showBlockedReason flashes the reason the tab at the given index can not be switched to on the footer line.
+func (s *Skeleton) showBlockedReason(index int) {
+	if reason := s.header.blockedReason(index); reason != "" {
+		s.SetStatusMessage(reason, blockedTabReasonTTL)
+	}
+}
@@ -0,0 +1,78 @@
+package skeleton
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// doubleClickInterval is the maximum time between two clicks of a double-click.
+const doubleClickInterval = 400 * time.Millisecond
+
+// TabAction is an action invoked on the tab by the given key, e.g. on double-click.
+type TabAction func(key string) tea.Cmd
+
+// lastClick is hold the last click on a tab, to detect double-clicks.
+type lastClick struct {
+	index int
+	at    time.Time
+}
+
+// TabActionRename returns an action which asks the user for a new title of the tab.
+func (s *Skeleton) TabActionRename() TabAction {
+	return func(key string) tea.Cmd {
+		index := s.pageIndex(key)
+		if index < 0 {
+			return nil
+		}
+		s.ShowPrompt("Rename tab", "", s.header.headers[index].title, func(value string, ok bool) {
+			if ok && value != "" {
+				s.UpdatePageTitle(key, value)
+			}
+		})
+		return nil
+	}
+}
+
+// TabActionPin returns an action which pins the tab to the left edge of the scrolling header, or unpins it.
+func (s *Skeleton) TabActionPin() TabAction {
+	return func(key string) tea.Cmd {
+		if s.GetTabSticky(key) == StickyNone {
+			s.SetTabSticky(key, StickyLeft)
+		} else {
+			s.SetTabSticky(key, StickyNone)
+		}
+		return nil
+	}
+}
+
+// TabActionClose returns an action which closes the tab like its close glyph, dirty pages are confirmed first.
+func (s *Skeleton) TabActionClose() TabAction {
+	return func(key string) tea.Cmd {
+		s.closePage(key)
+		return nil
+	}
+}
+
+// SetTabDoubleClickAction sets the action invoked when a tab title is double-clicked. Nil disables it.
+// Mouse support has to be enabled with SetMouseEnabled.
+func (s *Skeleton) SetTabDoubleClickAction(action TabAction) *Skeleton {
+	s.tabDoubleClickAction = action
+	return s
+}
+
+// registerTabClick records a click on the tab at the given index and returns the
+// double-click action command if it completes a double-click.
+func (s *Skeleton) registerTabClick(index int) tea.Cmd {
+	now := s.clock.Now()
+	previous := s.lastTabClick
+	s.lastTabClick = lastClick{index: index, at: now}
+
+	if s.tabDoubleClickAction == nil || previous.index != index || now.Sub(previous.at) > doubleClickInterval {
+		return nil
+	}
+
+	// a third click should not be another double-click
+	s.lastTabClick = lastClick{index: -1}
+	return s.tabDoubleClickAction(s.header.headers[index].key)
+}
@@ -20,17 +20,19 @@ func (e *explorer) Init() tea.Cmd {
 	return e.picker.Init()
 }
 
+// borderColorMsg sets the border color of the skeleton, the explorer receives it from its timers.
+type borderColorMsg string
+
 func (e *explorer) blinkTwiceBorder(color string) {
-	go func() {
-		defaultColor := e.skeleton.GetBorderColor()
-		for i := 0; i < 2; i++ {
-			e.skeleton.SetBorderColor(color)
-			time.Sleep(100 * time.Millisecond)
-			e.skeleton.SetBorderColor(defaultColor)
-			time.Sleep(100 * time.Millisecond)
+	// the timers deliver the colors to the explorer, so the skeleton is only changed on the update loop
+	defaultColor := e.skeleton.GetBorderColor()
+	for i := 0; i < 4; i++ {
+		next := color
+		if i%2 == 1 {
+			next = defaultColor
 		}
-		e.skeleton.SetBorderColor(defaultColor)
-	}()
+		e.skeleton.After("explorer", time.Duration(i)*100*time.Millisecond, borderColorMsg(next))
+	}
 }
 
 func (e *explorer) InitializeWidgets() {
@@ -41,6 +43,9 @@ func (e *explorer) InitializeWidgets() {
 
 func (e *explorer) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case borderColorMsg:
+		e.skeleton.SetBorderColor(string(msg))
+		return e, nil
 	case skeleton.IAMActivePage:
 		e.InitializeWidgets()
 	case tea.WindowSizeMsg:
@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/termkit/skeleton"
 
@@ -55,17 +54,8 @@ func main() {
 	// Battery level is hardcoded. You can use a library to get the battery level of your system.
 	s.AddWidget("battery", "Battery %92") // Add a widget to entire screen
 
-	// Add current time ( Optional )
-	s.AddWidget("time", time.Now().Format("15:04:05"))
-
-	// Update the time widget every second ( Optional )
-	go func() {
-		time.Sleep(time.Second)
-		for {
-			s.UpdateWidgetValue("time", time.Now().Format("15:04:05"))
-			time.Sleep(time.Second)
-		}
-	}()
+	// Add current time, the Skeleton updates it every second ( Optional )
+	s.AddClockWidget("time", "15:04:05")
 
 	p := tea.NewProgram(s)
 	if err := p.Start(); err != nil {
@@ -76,9 +76,6 @@ func (m *categoryModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
-	case skeleton.IAMActivePage:
-		m.skeleton.SetActiveTabBorderColor(m.color)
-
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "up", "k":
@@ -97,14 +94,12 @@ func (m *categoryModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 				// Create a unique key for the detail tab
 				detailKey := fmt.Sprintf("detail-%d", time.Now().UnixNano())
-				// Create a shorter title for the tab
-				shortTitle := news.Title
-				if len(shortTitle) > 20 {
-					shortTitle = shortTitle[:17] + "..."
-				}
-
-				// Add new detail tab
-				m.skeleton.AddPage(detailKey, shortTitle, newNewsDetailModel(m.skeleton, news))
+				// Add new detail tab, the title is truncated by the skeleton
+				detail := newNewsDetailModel(m.skeleton, news)
+				m.skeleton.AddPage(detailKey, news.Title, detail)
+				m.skeleton.SetTabColor(detailKey, detail.color, "")
+				m.skeleton.SetPageWordWrap(detailKey, true)                              // Wrap long summaries to the frame
+				m.skeleton.AddPageViewProcessor(detailKey, skeleton.SanitizeProcessor()) // The feed is untrusted
 				return m, nil
 			}
 		}
@@ -177,9 +172,6 @@ func (m *newsDetailModel) Init() tea.Cmd {
 
 func (m *newsDetailModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
-	case skeleton.IAMActivePage:
-		m.skeleton.SetActiveTabBorderColor(m.color)
-
 	case tea.KeyMsg:
 		if msg.Type == tea.KeyCtrlW {
 			if key := m.skeleton.GetActivePage(); key != "" {
@@ -256,7 +248,7 @@ func (m *categoryModel) fetchCmd() tea.Cmd {
 }
 
 func (m *categoryModel) updateWidgets() {
-	m.skeleton.UpdateWidgetValue("count", fmt.Sprintf("News: %d | Unread: %d", len(m.items), m.countUnread()))
+	m.skeleton.UpdatePageWidgetValue("news", "count", fmt.Sprintf("News: %d | Unread: %d", len(m.items), m.countUnread()))
 }
 
 func (m *categoryModel) countUnread() int {
@@ -284,23 +276,23 @@ func main() {
 	s := skeleton.NewSkeleton()
 
 	// Main tab with gruvbox theme
-	s.AddPage("news", "News", newCategoryModel(s, "https://dev.to/feed", "142")) // Gruvbox green
+	news := newCategoryModel(s, "https://dev.to/feed", "142") // Gruvbox green
+	s.AddPage("news", "News", news)
+	s.SetTabColor("news", news.color, "")
 
 	s.AddWidget("app", "News Reader")
-	s.AddWidget("count", "Loading...")
-	s.AddWidget("time", time.Now().Format("15:04:05"))
+	// the count is shown only on the news list, not on the opened articles
+	s.AddPageWidget("news", "count", "Loading...")
+	s.AddClockWidget("time", "15:04:05")
 
 	s.SetActiveTabBorderColor("142") // Gruvbox green
 	s.SetWidgetBorderColor("142")    // Gruvbox green
 	s.SetBorderColor("214")          // Gruvbox orange
+	s.SetTabMaxWidth(20)             // Truncate long article titles
 
-	// Update time every second
-	go func() {
-		for {
-			time.Sleep(time.Second)
-			s.UpdateWidgetValue("time", time.Now().Format("15:04:05"))
-		}
-	}()
+	// Keep the news tab and the last opened articles, the least recently read article tab is closed
+	s.SetTabSticky("news", skeleton.StickyLeft)
+	s.SetMaxPages(8, skeleton.EvictOldest)
 
 	p := tea.NewProgram(s)
 	if err := p.Start(); err != nil {
@@ -1,26 +1,55 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/shirou/gopsutil/v3/cpu"
 	"github.com/shirou/gopsutil/v3/disk"
 	"github.com/shirou/gopsutil/v3/mem"
+	"github.com/shirou/gopsutil/v3/process"
 	"github.com/termkit/skeleton"
+	"github.com/termkit/skeleton/components"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
+// -----------------------------------------------------------------------------
+// Stats
+
+// statsMsg is hold the system stats, it is delivered to all pages every second.
+type statsMsg struct {
+	cpu       float64
+	memUsed   uint64
+	memTotal  uint64
+	diskUsed  uint64
+	diskTotal uint64
+}
+
+// readStats reads the system stats, it runs outside the update loop so the blocking calls do not freeze the UI.
+func readStats(context.Context) tea.Msg {
+	var stats statsMsg
+	if percent, err := cpu.Percent(0, false); err == nil && len(percent) > 0 {
+		stats.cpu = percent[0]
+	}
+	if v, err := mem.VirtualMemory(); err == nil {
+		stats.memUsed, stats.memTotal = v.Used, v.Total
+	}
+	if usage, err := disk.Usage("/"); err == nil {
+		stats.diskUsed, stats.diskTotal = usage.Used, usage.Total
+	}
+	return stats
+}
+
 // -----------------------------------------------------------------------------
 // CPU Model
 type cpuModel struct {
 	skeleton    *skeleton.Skeleton
 	usage       float64
-	lastUpdate  time.Time
-	color       string // tab color
 	borderColor string // border color
 }
 
@@ -28,8 +57,6 @@ func newCPUModel(s *skeleton.Skeleton) *cpuModel {
 	return &cpuModel{
 		skeleton:    s,
 		usage:       0,
-		lastUpdate:  time.Now(),
-		color:       "39", // bright blue
 		borderColor: "27", // darker blue
 	}
 }
@@ -39,16 +66,11 @@ func (m *cpuModel) Init() tea.Cmd {
 }
 
 func (m *cpuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg.(type) {
-	case skeleton.UpdateMsg:
-		if time.Since(m.lastUpdate) >= time.Second {
-			if percent, err := cpu.Percent(0, false); err == nil && len(percent) > 0 {
-				m.usage = percent[0]
-			}
-			m.lastUpdate = time.Now()
-		}
+	switch msg := msg.(type) {
+	case statsMsg:
+		m.usage = msg.cpu
+		m.skeleton.SetWidgetGauge("cpu", msg.cpu)
 	case skeleton.IAMActivePage:
-		m.skeleton.SetActiveTabBorderColor(m.color)
 		m.skeleton.SetBorderColor(m.borderColor)
 	}
 	return m, nil
@@ -92,16 +114,12 @@ type memoryModel struct {
 	skeleton    *skeleton.Skeleton
 	used        uint64
 	total       uint64
-	lastUpdate  time.Time
-	color       string // tab color
 	borderColor string // border color
 }
 
 func newMemoryModel(s *skeleton.Skeleton) *memoryModel {
 	return &memoryModel{
 		skeleton:    s,
-		lastUpdate:  time.Now(),
-		color:       "162", // bright purple
 		borderColor: "126", // darker purple
 	}
 }
@@ -111,17 +129,13 @@ func (m *memoryModel) Init() tea.Cmd {
 }
 
 func (m *memoryModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg.(type) {
-	case skeleton.UpdateMsg:
-		if time.Since(m.lastUpdate) >= time.Second {
-			if v, err := mem.VirtualMemory(); err == nil {
-				m.used = v.Used
-				m.total = v.Total
-			}
-			m.lastUpdate = time.Now()
+	switch msg := msg.(type) {
+	case statsMsg:
+		m.used, m.total = msg.memUsed, msg.memTotal
+		if msg.memTotal > 0 {
+			m.skeleton.SetWidgetGauge("mem", float64(msg.memUsed)/float64(msg.memTotal)*100)
 		}
 	case skeleton.IAMActivePage:
-		m.skeleton.SetActiveTabBorderColor(m.color)
 		m.skeleton.SetBorderColor(m.borderColor)
 	}
 	return m, nil
@@ -174,17 +188,13 @@ type diskModel struct {
 	skeleton    *skeleton.Skeleton
 	used        uint64
 	total       uint64
-	lastUpdate  time.Time
-	color       string // tab color
 	borderColor string // border color
 }
 
 func newDiskModel(s *skeleton.Skeleton) *diskModel {
 	return &diskModel{
 		skeleton:    s,
-		lastUpdate:  time.Now(),
-		color:       "136", // bright gold
-		borderColor: "94",  // darker gold
+		borderColor: "94", // darker gold
 	}
 }
 
@@ -193,17 +203,10 @@ func (m *diskModel) Init() tea.Cmd {
 }
 
 func (m *diskModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	switch msg.(type) {
-	case skeleton.UpdateMsg:
-		if time.Since(m.lastUpdate) >= time.Second {
-			if usage, err := disk.Usage("/"); err == nil {
-				m.used = usage.Used
-				m.total = usage.Total
-			}
-			m.lastUpdate = time.Now()
-		}
+	switch msg := msg.(type) {
+	case statsMsg:
+		m.used, m.total = msg.diskUsed, msg.diskTotal
 	case skeleton.IAMActivePage:
-		m.skeleton.SetActiveTabBorderColor(m.color)
 		m.skeleton.SetBorderColor(m.borderColor)
 	}
 	return m, nil
@@ -297,6 +300,52 @@ func createProgressBar(opts progressBarOptions) (string, string) {
 
 // -----------------------------------------------------------------------------
 // Main Program
+// -----------------------------------------------------------------------------
+// Processes
+
+// listProcesses returns the running processes for the process table page.
+func listProcesses() ([]components.Process, error) {
+	procs, err := process.Processes()
+	if err != nil {
+		return nil, err
+	}
+
+	processes := make([]components.Process, 0, len(procs))
+	for _, p := range procs {
+		name, err := p.Name()
+		if err != nil {
+			continue // the process has exited
+		}
+		user, _ := p.Username()
+		cpuPercent, _ := p.CPUPercent()
+		memPercent, _ := p.MemoryPercent()
+		processes = append(processes, components.Process{
+			PID:    p.Pid,
+			Name:   name,
+			User:   user,
+			CPU:    cpuPercent,
+			Memory: float64(memPercent),
+		})
+	}
+	return processes, nil
+}
+
+// signalProcess sends the signal to the process and shows the result as a toast.
+func signalProcess(s *skeleton.Skeleton) components.SignalHandler {
+	return func(p components.Process, sig os.Signal) tea.Cmd {
+		proc, err := os.FindProcess(int(p.PID))
+		if err == nil {
+			err = proc.Signal(sig)
+		}
+		if err != nil {
+			s.Notify(skeleton.NotifyError, fmt.Sprintf("%s: %v", p.Name, err), 3*time.Second)
+		} else {
+			s.Notify(skeleton.NotifyInfo, fmt.Sprintf("sent %v to %s", sig, p.Name), 3*time.Second)
+		}
+		return nil
+	}
+}
+
 func main() {
 	s := skeleton.NewSkeleton()
 
@@ -305,23 +354,33 @@ func main() {
 	s.AddPage("memory", "Memory", newMemoryModel(s))
 	s.AddPage("disk", "Disk", newDiskModel(s))
 
-	s.SetActiveTabBorderColor("39") // dark blue
+	processes := components.NewProcessTablePage(s, "processes", listProcesses).
+		SetSignalHandler(signalProcess(s))
+	s.AddPage("processes", "Processes", processes)
+	processes.Start(2 * time.Second)
+
+	// Each tab keeps its own color
+	s.SetTabColor("cpu", "39", "")     // bright blue
+	s.SetTabColor("memory", "162", "") // bright purple
+	s.SetTabColor("disk", "136", "")   // bright gold
 
 	// Add widgets
 	s.AddWidget("app", "System Monitor")
-	s.AddWidget("time", time.Now().Format("15:04:05"))
-
-	// Update system stats every second
-	go func() {
-		for {
-			time.Sleep(time.Second)
-			s.TriggerUpdate()
-			s.UpdateWidgetValue("time", time.Now().Format("15:04:05"))
+	s.AddGaugeWidget("cpu", "CPU", 0, 100)
+	s.AddGaugeWidget("mem", "MEM", 0, 100)
+	s.AddDynamicWidget("procs", 5*time.Second, func() string {
+		pids, err := process.Pids()
+		if err != nil {
+			return "Procs: ?"
 		}
-	}()
+		return fmt.Sprintf("Procs: %d", len(pids))
+	})
+	s.AddClockWidget("time", "15:04:05")
+
+	// Read the system stats every second, the pages apply them on the update loop
+	s.Every(time.Second, readStats)
 
-	p := tea.NewProgram(s)
-	if err := p.Start(); err != nil {
+	if err := s.Run(); err != nil {
 		panic(err)
 	}
 }
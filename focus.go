@@ -0,0 +1,163 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// RegionFocusMsg is sent to the active page when the keyboard focus moves to another region.
+// While the header or the widgets are focused, the navigation keys are handled by that region.
+type RegionFocusMsg struct {
+	Region RenderRegion
+}
+
+// regionKeyMap is hold the keys handled by the focused header or widget region.
+type regionKeyMap struct {
+	Previous key.Binding
+	Next     key.Binding
+	Activate key.Binding
+	Leave    key.Binding
+}
+
+// regionKeys are the keys handled by the focused header or widget region.
+var regionKeys = regionKeyMap{
+	Previous: key.NewBinding(key.WithKeys("left", "up")),
+	Next:     key.NewBinding(key.WithKeys("right", "down")),
+	Activate: key.NewBinding(key.WithKeys("enter")),
+	Leave:    key.NewBinding(key.WithKeys("esc")),
+}
+
+// SetFocusFollowsMouse moves the keyboard focus to the region under the mouse cursor when it moves.
+// When disabled, which is the default, a region is focused by clicking it. A click on the tabs focuses
+// the page body, the header is focused by FocusRegion or by moving the mouse over it when enabled.
+func (s *Skeleton) SetFocusFollowsMouse(follow bool) *Skeleton {
+	s.properties.focusFollowsMouse = follow
+	s.updater.UpdateWithMsg(mouseModeMsg{enabled: s.properties.mouseEnabled})
+	return s
+}
+
+// IsFocusFollowsMouse returns the keyboard focus follows the mouse cursor or not.
+func (s *Skeleton) IsFocusFollowsMouse() bool {
+	return s.properties.focusFollowsMouse
+}
+
+// FocusRegion moves the keyboard focus to the given region. The header region is the tab strip or the sidebar.
+func (s *Skeleton) FocusRegion(region RenderRegion) *Skeleton {
+	if cmd := s.setFocus(region); cmd != nil {
+		s.updater.UpdateWithMsg(RegionFocusMsg{Region: region})
+	}
+	return s
+}
+
+// GetFocusedRegion returns the region which has the keyboard focus.
+func (s *Skeleton) GetFocusedRegion() RenderRegion {
+	if s.focusedRegion == "" {
+		return RegionBody
+	}
+	return s.focusedRegion
+}
+
+// setFocus moves the keyboard focus to the given region, it returns a command which notifies
+// the active page if the focus changed.
+func (s *Skeleton) setFocus(region RenderRegion) tea.Cmd {
+	if region == s.GetFocusedRegion() {
+		return nil
+	}
+	s.focusedRegion = region
+
+	s.widget.focusedWidget = -1
+	if region == RegionWidgets {
+		// the first shown widget is focused
+		s.widget.moveFocus(1)
+	}
+	s.updater.Update()
+
+	return func() tea.Msg {
+		return RegionFocusMsg{Region: region}
+	}
+}
+
+// regionAt returns the region at the given cell of the terminal.
+func (s *Skeleton) regionAt(x, y int) RenderRegion {
+	inFooter, inHeader := s.edgesAt(y)
+	switch {
+	case inFooter:
+		return RegionWidgets
+	case inHeader:
+		return RegionHeader
+	case s.header.isSidebar() && x >= 1 && x <= s.header.sidebarWidth():
+		return RegionHeader
+	default:
+		return RegionBody
+	}
+}
+
+// focusFromMouse focuses the region under the mouse when it is clicked, or when it moves and
+// the focus follows the mouse. A click on the header activates the tab, so the focus goes to the body
+// and the page keeps its keys.
+func (s *Skeleton) focusFromMouse(msg tea.MouseMsg) tea.Cmd {
+	if s.IsModalOpen() {
+		return nil
+	}
+	region := s.regionAt(msg.X, msg.Y)
+	switch {
+	case msg.Action == tea.MouseActionPress && msg.Button == tea.MouseButtonLeft:
+		if region == RegionHeader {
+			region = RegionBody
+		}
+	case msg.Action == tea.MouseActionMotion && s.properties.focusFollowsMouse:
+	default:
+		return nil
+	}
+	return s.setFocus(region)
+}
+
+// handleFocusKey handles the navigation keys of the focused header or widget region.
+// It returns true if the key is consumed and must not be delivered to the page.
+func (s *Skeleton) handleFocusKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	region := s.GetFocusedRegion()
+	if region == RegionBody {
+		return nil, false
+	}
+
+	switch {
+	case key.Matches(msg, regionKeys.Leave):
+		return s.setFocus(RegionBody), true
+	case region == RegionHeader && key.Matches(msg, regionKeys.Previous):
+		return tea.Batch(s.switchPage(nil, "left")...), true
+	case region == RegionHeader && key.Matches(msg, regionKeys.Next):
+		return tea.Batch(s.switchPage(nil, "right")...), true
+	case region == RegionHeader && key.Matches(msg, regionKeys.Activate):
+		return s.setFocus(RegionBody), true
+	case region == RegionWidgets && key.Matches(msg, regionKeys.Previous):
+		s.widget.moveFocus(-1)
+		return nil, true
+	case region == RegionWidgets && key.Matches(msg, regionKeys.Next):
+		s.widget.moveFocus(1)
+		return nil, true
+	case region == RegionWidgets && key.Matches(msg, regionKeys.Activate):
+		if i := s.widget.focusedWidget; i >= 0 && i < len(s.widget.widgets) {
+			if handler, ok := s.widget.clickHandlers[s.widget.widgets[i].Key]; ok {
+				return handler(), true
+			}
+		}
+		return nil, true
+	}
+	return nil, false
+}
+
+// moveFocus moves the focused widget by the given delta, it wraps around.
+func (w *widget) moveFocus(delta int) {
+	if len(w.shownWidgets()) == 0 {
+		w.focusedWidget = -1
+		return
+	}
+	// the widgets of the inactive pages are skipped
+	for {
+		w.focusedWidget = ((w.focusedWidget+delta)%len(w.widgets) + len(w.widgets)) % len(w.widgets)
+		if w.isShown(w.widgets[w.focusedWidget]) {
+			break
+		}
+	}
+	w.updater.Update()
+}
@@ -0,0 +1,25 @@
+package skeleton
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestTabClickKeepsFocusOnBody(t *testing.T) {
+	s := NewSkeleton()
+	s.AddPage("first", "First", newTestPage())
+	s.AddPage("second", "Second", newTestPage())
+	updateSync(s, tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	updateSync(s, tea.MouseMsg{X: 3, Y: 1, Action: tea.MouseActionPress, Button: tea.MouseButtonLeft})
+	if got := s.GetFocusedRegion(); got != RegionBody {
+		t.Fatalf("focused region after a tab click is %v, want %v", got, RegionBody)
+	}
+
+	active := s.GetActivePage()
+	updateSync(s, tea.KeyMsg{Type: tea.KeyRight})
+	if got := s.GetActivePage(); got != active {
+		t.Errorf("right switched the page from %q to %q, the header took the key", active, got)
+	}
+}
@@ -0,0 +1,64 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// FooterProvider is implemented by the pages which supply their own footer content, e.g. the key hints
+// of the page. While such a page is active its content replaces the global widget bar, the widgets are
+// shown again when another page is activated.
+type FooterProvider interface {
+	// Footer returns the content of the footer for the given width, it is rendered on the bottom border
+	// of the frame next to the status message. Empty content shows the widget bar.
+	Footer(width int) string
+}
+
+// activePageFooter returns the footer content of the active page, false if it doesn't provide one.
+func (s *Skeleton) activePageFooter(width int) (string, bool) {
+	if s.currentTab < 0 || s.currentTab >= len(s.pages) {
+		return "", false
+	}
+	provider, ok := s.pages[s.currentTab].(FooterProvider)
+	if !ok {
+		return "", false
+	}
+	content := provider.Footer(width)
+	return content, content != ""
+}
+
+// pageFooterWidth returns the width available for the footer content of a page.
+func (w *widget) pageFooterWidth() int {
+	return max(w.viewport.Width-5, 0) // for the corners, at least one line and the spaces around the content
+}
+
+// hasPageFooter returns true if the active page replaces the widget bar with its own footer.
+func (w *widget) hasPageFooter() bool {
+	if w.pageFooter == nil {
+		return false
+	}
+	_, ok := w.pageFooter(w.pageFooterWidth())
+	return ok
+}
+
+// pageFooterView renders the footer content of the active page on the bottom border line.
+func (w *widget) pageFooterView() (string, bool) {
+	if w.pageFooter == nil {
+		return "", false
+	}
+	content, ok := w.pageFooter(w.pageFooterWidth())
+	if !ok {
+		return "", false
+	}
+
+	borderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(w.properties.borderColor))
+	frame := w.properties.glyphs.Frame
+
+	// the content is kept apart from the corner by a space on both sides
+	content = " " + truncateText(content, w.pageFooterWidth()) + " "
+	line := w.renderLine(max(w.viewport.Width-2-lipgloss.Width(content), 0))
+
+	leftCorner := borderStyle.Render(lipgloss.JoinVertical(lipgloss.Top, frame.Left, frame.BottomLeft))
+	rightCorner := borderStyle.Render(lipgloss.JoinVertical(lipgloss.Top, frame.Right, frame.BottomRight))
+
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, leftCorner, line+content, rightCorner), true
+}
@@ -0,0 +1,112 @@
+package skeleton
+
+import (
+	"sync"
+	"time"
+)
+
+// Frame is a composed frame of the Skeleton, as it is rendered to the terminal.
+type Frame struct {
+	// View is the rendered frame with its styles
+	View string
+
+	// Size is the size the frame is rendered at
+	Size Size
+
+	// Time is when the frame is composed
+	Time time.Time
+}
+
+// FrameHandler is called with every composed frame which differs from the previous one.
+type FrameHandler func(frame Frame)
+
+// frameCapture is hold the subscribers of the composed frames.
+type frameCapture struct {
+	mu       sync.Mutex
+	handlers map[int]FrameHandler
+	nextID   int
+	last     string
+	lastSize Size
+}
+
+// OnFrame calls the handler with every composed frame which differs from the previous one, e.g. to pipe
+// the output to a web viewer or a recorder. The handler is called while the frame is rendered, so it
+// has to return quickly. The returned function removes the handler.
+func (s *Skeleton) OnFrame(handler FrameHandler) (cancel func()) {
+	if handler == nil {
+		return func() {}
+	}
+
+	c := &s.frameCapture
+	c.mu.Lock()
+	if c.handlers == nil {
+		c.handlers = make(map[int]FrameHandler)
+	}
+	id := c.nextID
+	c.nextID++
+	c.handlers[id] = handler
+	c.last = ""
+	c.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			delete(c.handlers, id)
+			c.mu.Unlock()
+		})
+	}
+}
+
+// Frames returns a channel which receives every composed frame which differs from the previous one.
+// Frames are dropped while the channel buffer is full, so a slow reader never blocks the rendering.
+// The returned function stops the stream and closes the channel.
+func (s *Skeleton) Frames(buffer int) (<-chan Frame, func()) {
+	frames := make(chan Frame, max(buffer, 1))
+	var mu sync.Mutex
+	closed := false
+
+	cancel := s.OnFrame(func(frame Frame) {
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return
+		}
+		select {
+		case frames <- frame:
+		default:
+		}
+	})
+
+	return frames, func() {
+		cancel()
+		mu.Lock()
+		defer mu.Unlock()
+		if !closed {
+			closed = true
+			close(frames)
+		}
+	}
+}
+
+// publishFrame calls the frame handlers if the frame differs from the previous one.
+func (s *Skeleton) publishFrame(view string) {
+	c := &s.frameCapture
+	c.mu.Lock()
+	size := Size{Width: s.viewport.Width, Height: s.viewport.Height}
+	if len(c.handlers) == 0 || (view == c.last && size == c.lastSize) {
+		c.mu.Unlock()
+		return
+	}
+	c.last, c.lastSize = view, size
+	handlers := make([]FrameHandler, 0, len(c.handlers))
+	for _, handler := range c.handlers {
+		handlers = append(handlers, handler)
+	}
+	c.mu.Unlock()
+
+	frame := Frame{View: view, Size: size, Time: s.clock.Now()}
+	for _, handler := range handlers {
+		handler(frame)
+	}
+}
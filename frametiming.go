@@ -0,0 +1,120 @@
+package skeleton
+
+import (
+	"time"
+)
+
+// RenderRegion is a region of the rendered frame.
+type RenderRegion string
+
+const (
+	// RegionHeader is the tab bar.
+	RegionHeader RenderRegion = "header"
+	// RegionBody is the active page with the overlays.
+	RegionBody RenderRegion = "body"
+	// RegionWidgets is the widget bar (footer).
+	RegionWidgets RenderRegion = "widgets"
+)
+
+// defaultSlowFrameThreshold is the default number of consecutive slow frames before a SlowRenderMsg.
+const defaultSlowFrameThreshold = 3
+
+// FrameTiming is hold the composition time of a rendered frame.
+type FrameTiming struct {
+	// Total is the time of the whole frame
+	Total time.Duration `json:"total"`
+
+	// Header, Body and Widgets are the times of the regions
+	Header  time.Duration `json:"header"`
+	Body    time.Duration `json:"body"`
+	Widgets time.Duration `json:"widgets"`
+}
+
+// Slowest returns the region which took the most time and its time.
+func (f FrameTiming) Slowest() (RenderRegion, time.Duration) {
+	region, duration := RegionHeader, f.Header
+	if f.Body > duration {
+		region, duration = RegionBody, f.Body
+	}
+	if f.Widgets > duration {
+		region, duration = RegionWidgets, f.Widgets
+	}
+	return region, duration
+}
+
+// SlowRenderMsg is sent to all pages when the frame budget is exceeded repeatedly.
+type SlowRenderMsg struct {
+	// Region is the slowest region of the last slow frame
+	Region RenderRegion
+
+	// Timing is the timing of the last slow frame
+	Timing FrameTiming
+
+	// Budget is the configured frame budget
+	Budget time.Duration
+
+	// Frames is the number of consecutive frames which exceeded the budget
+	Frames int
+}
+
+// frameTimer is hold the frame budget and the last timings.
+type frameTimer struct {
+	budget     time.Duration
+	threshold  int
+	slowFrames int
+	last       FrameTiming
+}
+
+// SetFrameBudget sets the time budget of a frame. SlowRenderMsg is sent after threshold consecutive
+// frames exceeded it, a non-positive threshold uses the default of 3. Zero budget disables it.
+func (s *Skeleton) SetFrameBudget(budget time.Duration, threshold int) *Skeleton {
+	if threshold <= 0 {
+		threshold = defaultSlowFrameThreshold
+	}
+	s.frameTimer.budget = budget
+	s.frameTimer.threshold = threshold
+	s.frameTimer.slowFrames = 0
+	return s
+}
+
+// GetFrameBudget returns the time budget of a frame.
+func (s *Skeleton) GetFrameBudget() time.Duration {
+	return s.frameTimer.budget
+}
+
+// GetFrameTiming returns the timing of the last rendered frame.
+func (s *Skeleton) GetFrameTiming() FrameTiming {
+	return s.frameTimer.last
+}
+
+// recordFrame records the timing of a rendered frame and reports repeatedly slow frames.
+func (s *Skeleton) recordFrame(timing FrameTiming) {
+	t := &s.frameTimer
+	t.last = timing
+
+	s.profiler.record("header/view", timing.Header)
+	s.profiler.record("widgets/view", timing.Widgets)
+	s.profiler.record("frame", timing.Total)
+
+	if t.budget <= 0 {
+		return
+	}
+	if timing.Total <= t.budget {
+		t.slowFrames = 0
+		return
+	}
+
+	t.slowFrames++
+	if t.slowFrames < t.threshold {
+		return
+	}
+
+	region, _ := timing.Slowest()
+	s.broadcast(SlowRenderMsg{
+		Region: region,
+		Timing: timing,
+		Budget: t.budget,
+		Frames: t.slowFrames,
+	})
+	t.slowFrames = 0
+}
@@ -0,0 +1,387 @@
+package skeleton
+
+import (
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// fuzzMaxSteps limits the number of steps decoded from a fuzz input.
+const fuzzMaxSteps = 200
+
+// fuzzCmdTimeout is how long a command may block without waiting for the clock.
+const fuzzCmdTimeout = 5 * time.Second
+
+// fuzzPage is a page which echoes the last message it received.
+type fuzzPage struct {
+	last string
+}
+
+func (p fuzzPage) Init() tea.Cmd { return nil }
+
+func (p fuzzPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	p.last = fmt.Sprintf("%T", msg)
+	return p, nil
+}
+
+func (p fuzzPage) View() string { return "last message: " + p.last }
+
+// fuzzMsg is a custom message which is unknown to the Skeleton.
+type fuzzMsg struct {
+	n int
+}
+
+// cmdPanic is returned by a command which panicked.
+type cmdPanic struct {
+	value any
+	stack []byte
+}
+
+// fuzzInput decodes the steps of a run from the fuzz input, it reads zeros once the input is consumed.
+type fuzzInput struct {
+	data []byte
+}
+
+// intn returns the next number of the input in [0, n).
+func (in *fuzzInput) intn(n int) int {
+	if len(in.data) == 0 {
+		return 0
+	}
+	b := in.data[0]
+	in.data = in.data[1:]
+	return int(b) % n
+}
+
+// parkedCmd is hold a command which waits for the clock.
+type parkedCmd struct {
+	result   chan tea.Msg
+	released bool
+}
+
+// fuzzClock is a SimulatedClock which reports the commands waiting for it, so they can be run one by one.
+type fuzzClock struct {
+	*SimulatedClock
+	running *parkedCmd
+	parked  chan struct{}
+}
+
+// AfterFunc parks the running command, the timers of the update loop are scheduled as they are.
+func (c *fuzzClock) AfterFunc(d time.Duration, f func()) ClockTimer {
+	cmd := c.running
+	if cmd == nil {
+		return c.SimulatedClock.AfterFunc(d, f)
+	}
+
+	c.running = nil
+	timer := c.SimulatedClock.AfterFunc(d, func() {
+		f()
+		cmd.released = true
+	})
+	c.parked <- struct{}{}
+	return timer
+}
+
+// fuzzer is hold the state of a single run. The commands run one at a time and the messages of the updater
+// are delivered in order, so a run depends on its input only and a failing input replays.
+type fuzzer struct {
+	s      *Skeleton
+	clock  *fuzzClock
+	in     *fuzzInput
+	parked []*parkedCmd
+	trace  []string
+	width  int
+	height int
+}
+
+// newFuzzer returns a new fuzzer of the given input.
+func newFuzzer(data []byte) *fuzzer {
+	clock := &fuzzClock{
+		SimulatedClock: NewSimulatedClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+		parked:         make(chan struct{}, 1),
+	}
+	s := NewSkeleton().SetClock(clock)
+	// the fuzzer reads the updater itself instead of a listening command
+	s.updater.listening = true
+
+	return &fuzzer{s: s, clock: clock, in: &fuzzInput{data: data}}
+}
+
+func FuzzUpdate(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9})
+	f.Add([]byte("skeleton"))
+	f.Add([]byte{2, 4, 0, 4, 1, 4, 11, 200, 4, 9, 4, 10, 4, 11, 250, 0, 18, 0, 12, 2, 60, 20})
+	f.Add([]byte{0, 4, 15, 0, 4, 0, 7, 4, 16, 2, 3, 3, 4, 14, 1, 1, 1, 50, 40, 4, 17, 2})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		fz := newFuzzer(data)
+		if err := fz.run(); err != nil {
+			t.Fatalf("%v\nsteps:\n%s", err, strings.Join(fz.trace, "\n"))
+		}
+	})
+}
+
+// run executes the steps of the input and returns the first panic or broken invariant.
+func (f *fuzzer) run() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v\n%s", r, debug.Stack())
+		}
+	}()
+	// release the commands waiting for the clock
+	defer func() {
+		f.clock.Advance(24 * time.Hour)
+		for _, cmd := range f.parked {
+			<-cmd.result
+		}
+	}()
+
+	f.s.SetMouseEnabled(true)
+	// Init requires at least one page, the others are added and deleted by the steps
+	for i := range f.in.intn(3) + 1 {
+		f.s.AddPage(fmt.Sprintf("p%d", i), fmt.Sprintf("Page %d", i), fuzzPage{})
+	}
+	f.s.AddWidget("w0", "widget")
+	f.s.AddClockWidget("clock", "")
+
+	if err := f.exec(f.s.Init()); err != nil {
+		return err
+	}
+	if err := f.update(tea.WindowSizeMsg{Width: 80, Height: 24}); err != nil {
+		return err
+	}
+
+	for i := 0; i < fuzzMaxSteps && len(f.in.data) > 0; i++ {
+		if err := f.step(); err != nil {
+			return fmt.Errorf("step %d: %w", i, err)
+		}
+		_ = f.s.View()
+	}
+	return nil
+}
+
+// step executes a single message or API call and the commands it results in.
+func (f *fuzzer) step() error {
+	var msg tea.Msg
+	switch f.in.intn(5) {
+	case 0:
+		msg = f.key()
+	case 1:
+		msg = tea.MouseMsg{
+			X:      f.in.intn(f.width+4) - 2,
+			Y:      f.in.intn(f.height+4) - 2,
+			Button: tea.MouseButton(f.in.intn(int(tea.MouseButton11) + 1)),
+			Action: tea.MouseAction(f.in.intn(int(tea.MouseActionMotion) + 1)),
+		}
+	case 2:
+		msg = tea.WindowSizeMsg{Width: f.in.intn(200) - 10, Height: f.in.intn(60) - 5}
+	case 3:
+		msg = fuzzMsg{n: f.in.intn(256)}
+	default:
+		f.call()
+		return f.drain()
+	}
+
+	return f.update(msg)
+}
+
+// key returns the next key press of the input.
+func (f *fuzzer) key() tea.KeyMsg {
+	types := []tea.KeyType{
+		tea.KeyTab, tea.KeyShiftTab, tea.KeyLeft, tea.KeyRight, tea.KeyUp, tea.KeyDown,
+		tea.KeyEnter, tea.KeyEsc, tea.KeyBackspace, tea.KeyHome, tea.KeyEnd, tea.KeyPgUp, tea.KeyPgDown,
+		tea.KeyCtrlC, tea.KeyCtrlW, tea.KeyCtrlT, tea.KeyCtrlZ, tea.KeyF1, tea.KeySpace,
+		tea.KeyCtrlLeft, tea.KeyCtrlRight, tea.KeyCtrlShiftLeft, tea.KeyCtrlShiftRight,
+	}
+	runes := []rune("qQ?/123456789hjklnwxz ")
+
+	if f.in.intn(2) == 0 {
+		return tea.KeyMsg{Type: types[f.in.intn(len(types))], Alt: f.in.intn(4) == 0}
+	}
+	return tea.KeyMsg{
+		Type:  tea.KeyRunes,
+		Runes: []rune{runes[f.in.intn(len(runes))]},
+		Alt:   f.in.intn(4) == 0,
+	}
+}
+
+// call calls a method of the Skeleton API.
+func (f *fuzzer) call() {
+	s := f.s
+	page := fmt.Sprintf("p%d", f.in.intn(6))
+	wgt := fmt.Sprintf("w%d", f.in.intn(6))
+
+	switch f.in.intn(18) {
+	case 0:
+		f.record("AddPage(%q)", page)
+		s.AddPage(page, strings.Repeat("Title ", f.in.intn(6)), fuzzPage{})
+	case 1:
+		f.record("DeletePage(%q)", page)
+		s.DeletePage(page)
+	case 2:
+		f.record("SetActivePage(%q)", page)
+		s.SetActivePage(page)
+	case 3:
+		f.record("UpdateWidgetValue(%q)", wgt)
+		s.UpdateWidgetValue(wgt, strings.Repeat("界x", f.in.intn(20)))
+	case 4:
+		f.record("DeleteWidget(%q)", wgt)
+		s.DeleteWidget(wgt)
+	case 5:
+		f.record("LockTab(%q)", page)
+		s.LockTab(page)
+	case 6:
+		f.record("UnlockTab(%q)", page)
+		s.UnlockTab(page)
+	case 7:
+		f.record("DisableTab(%q)", page)
+		s.DisableTab(page)
+	case 8:
+		f.record("EnableTab(%q)", page)
+		s.EnableTab(page)
+	case 9:
+		f.record("SetStatusMessage")
+		s.SetStatusMessage("status", time.Duration(f.in.intn(3))*time.Second)
+	case 10:
+		f.record("Notify")
+		s.Notify(NotifyLevel(f.in.intn(3)), "toast", time.Second)
+	case 11:
+		d := time.Duration(f.in.intn(256)) * 12 * time.Millisecond
+		f.record("Advance(%s)", d)
+		f.clock.Advance(d)
+	case 12:
+		f.record("ReopenClosedPage")
+		s.ReopenClosedPage()
+	case 13:
+		f.record("MovePageLeft")
+		s.MovePageLeft()
+	case 14:
+		layout := TabLayoutMode(f.in.intn(2))
+		position := HeaderPosition(f.in.intn(2))
+		f.record("SetTabLayout(%d) SetHeaderPosition(%d)", layout, position)
+		s.SetTabLayout(layout).SetHeaderPosition(position)
+	case 15:
+		f.record("ShowConfirm")
+		s.ShowConfirm("confirm", "continue?", func(bool) {})
+	case 16:
+		f.record("CloseModal")
+		s.CloseModal()
+	default:
+		rows := f.in.intn(3)
+		f.record("SetWidgetRows(%d)", rows)
+		s.SetWidgetRows(rows)
+	}
+}
+
+// update delivers the message to the Skeleton and executes the commands it results in.
+func (f *fuzzer) update(msg tea.Msg) error {
+	f.record("%T %+v", msg, msg)
+	if size, ok := msg.(tea.WindowSizeMsg); ok && size.Width > 0 && size.Height > 0 {
+		f.width, f.height = size.Width, size.Height
+	}
+
+	if err := f.deliver(msg); err != nil {
+		return err
+	}
+	return f.drain()
+}
+
+// deliver handles a message, batches and sequences are executed in order.
+func (f *fuzzer) deliver(msg tea.Msg) error {
+	switch msg := msg.(type) {
+	case nil, tea.QuitMsg:
+		return nil
+	case cmdPanic:
+		return fmt.Errorf("command panic: %v\n%s", msg.value, msg.stack)
+	}
+
+	// tea.BatchMsg and the unexported sequence message are both lists of commands
+	if v := reflect.ValueOf(msg); v.Kind() == reflect.Slice && v.Type().Elem() == reflect.TypeOf(tea.Cmd(nil)) {
+		for i := 0; i < v.Len(); i++ {
+			if err := f.exec(v.Index(i).Interface().(tea.Cmd)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	_, cmd := f.s.Update(msg)
+	if err := f.s.CheckInvariants(); err != nil {
+		return fmt.Errorf("after %T: %w", msg, err)
+	}
+	return f.exec(cmd)
+}
+
+// exec runs the command and delivers its message. A command which waits for the clock is parked and its
+// message is delivered once the clock releases it.
+func (f *fuzzer) exec(cmd tea.Cmd) error {
+	if cmd == nil {
+		return nil
+	}
+
+	parked := &parkedCmd{result: make(chan tea.Msg, 1)}
+	f.clock.running = parked
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				parked.result <- cmdPanic{value: r, stack: debug.Stack()}
+			}
+		}()
+		parked.result <- cmd()
+	}()
+
+	select {
+	case msg := <-parked.result:
+		f.clock.running = nil
+		return f.deliver(msg)
+	case <-f.clock.parked:
+		f.parked = append(f.parked, parked)
+		return nil
+	case <-time.After(fuzzCmdTimeout):
+		return fmt.Errorf("command blocked for %s", fuzzCmdTimeout)
+	}
+}
+
+// drain delivers the messages of the updater and of the commands released by the clock until there are none.
+func (f *fuzzer) drain() error {
+	for {
+		select {
+		case msg := <-f.s.updater.rcv:
+			if err := f.deliver(msg); err != nil {
+				return err
+			}
+			continue
+		default:
+		}
+
+		i := f.releasedIndex()
+		if i < 0 {
+			return nil
+		}
+		cmd := f.parked[i]
+		f.parked = append(f.parked[:i], f.parked[i+1:]...)
+		if err := f.deliver(<-cmd.result); err != nil {
+			return err
+		}
+	}
+}
+
+// releasedIndex returns the index of the first parked command released by the clock, -1 if there is none.
+func (f *fuzzer) releasedIndex() int {
+	for i, cmd := range f.parked {
+		if cmd.released {
+			return i
+		}
+	}
+	return -1
+}
+
+// record adds a step to the trace of the run.
+func (f *fuzzer) record(format string, args ...any) {
+	f.trace = append(f.trace, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,253 @@
+package skeleton
+
+import (
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// GlyphSupport is the level of glyphs the terminal (and its font) can render.
+type GlyphSupport int
+
+const (
+	// GlyphSupportAuto detects the support from the environment.
+	GlyphSupportAuto GlyphSupport = iota
+	// GlyphSupportASCII can render only ASCII characters.
+	GlyphSupportASCII
+	// GlyphSupportUnicode can render unicode box drawing characters.
+	GlyphSupportUnicode
+	// GlyphSupportNerdFont can render powerline and nerd-font glyphs.
+	GlyphSupportNerdFont
+)
+
+// glyphSupportEnv is the environment variable which overrides the glyph support detection.
+// Accepted values are "ascii", "unicode" and "nerdfont".
+const glyphSupportEnv = "SKELETON_GLYPHS"
+
+// GlyphSet is hold the characters used to draw the frame, tabs and widgets.
+type GlyphSet struct {
+	// Frame is the border around the whole Skeleton
+	Frame lipgloss.Border
+
+	// ActiveTab is the border of the active tab
+	ActiveTab lipgloss.Border
+
+	// InactiveTab is the border of the inactive tabs
+	InactiveTab lipgloss.Border
+
+	// DisabledTab is the border of the disabled (locked) tabs, InactiveTab is used if it is empty
+	DisabledTab lipgloss.Border
+
+	// Widget is the border of the widgets
+	Widget lipgloss.Border
+
+	// TabLeft and TabRight join the tabs to the header line
+	TabLeft  string
+	TabRight string
+
+	// WidgetLeft and WidgetRight join the widgets to the footer line
+	WidgetLeft  string
+	WidgetRight string
+
+	// Separator is rendered between the status bar segments
+	Separator string
+
+	// Requires is the glyph support required to render this set
+	Requires GlyphSupport
+}
+
+// UnicodeGlyphs returns the default glyph set built from unicode box drawing characters.
+func UnicodeGlyphs() GlyphSet {
+	return GlyphSet{
+		Frame:       lipgloss.RoundedBorder(),
+		ActiveTab:   lipgloss.DoubleBorder(),
+		InactiveTab: lipgloss.RoundedBorder(),
+		DisabledTab: lipgloss.RoundedBorder(),
+		Widget:      lipgloss.RoundedBorder(),
+		TabLeft:     "┤",
+		TabRight:    "├",
+		WidgetLeft:  "┤",
+		WidgetRight: "├",
+		Separator:   "│",
+		Requires:    GlyphSupportUnicode,
+	}
+}
+
+// NerdFontGlyphs returns a powerline styled glyph set. It requires a nerd-font patched font.
+func NerdFontGlyphs() GlyphSet {
+	return GlyphSet{
+		Frame:       lipgloss.RoundedBorder(),
+		ActiveTab:   lipgloss.DoubleBorder(),
+		InactiveTab: lipgloss.RoundedBorder(),
+		DisabledTab: lipgloss.RoundedBorder(),
+		Widget:      lipgloss.RoundedBorder(),
+		TabLeft:     "",
+		TabRight:    "",
+		WidgetLeft:  "",
+		WidgetRight: "",
+		Separator:   "",
+		Requires:    GlyphSupportNerdFont,
+	}
+}
+
+// ASCIIGlyphs returns a glyph set which uses only ASCII characters.
+func ASCIIGlyphs() GlyphSet {
+	return GlyphSet{
+		Frame:       lipgloss.ASCIIBorder(),
+		ActiveTab:   lipgloss.ASCIIBorder(),
+		InactiveTab: lipgloss.ASCIIBorder(),
+		DisabledTab: lipgloss.ASCIIBorder(),
+		Widget:      lipgloss.ASCIIBorder(),
+		TabLeft:     "+",
+		TabRight:    "+",
+		WidgetLeft:  "+",
+		WidgetRight: "+",
+		Separator:   "|",
+		Requires:    GlyphSupportASCII,
+	}
+}
+
+// activeTabBorder returns the border of the active tab joined to the header line.
+func (g GlyphSet) activeTabBorder() lipgloss.Border {
+	b := g.ActiveTab
+	b.Left = g.TabLeft
+	b.Right = g.TabRight
+	return b
+}
+
+// inactiveTabBorder returns the border of the inactive tabs joined to the header line.
+func (g GlyphSet) inactiveTabBorder() lipgloss.Border {
+	b := g.InactiveTab
+	b.Left = g.TabLeft
+	b.Right = g.TabRight
+	return b
+}
+
+// disabledTabBorder returns the border of the disabled tabs joined to the header line.
+func (g GlyphSet) disabledTabBorder() lipgloss.Border {
+	b := g.DisabledTab
+	if b == (lipgloss.Border{}) {
+		b = g.InactiveTab
+	}
+	b.Left = g.TabLeft
+	b.Right = g.TabRight
+	return b
+}
+
+// widgetBorder returns the border of the widgets joined to the footer line.
+func (g GlyphSet) widgetBorder() lipgloss.Border {
+	b := g.Widget
+	b.Left = g.WidgetLeft
+	b.Right = g.WidgetRight
+	return b
+}
+
+// resolveGlyphSet returns the given set if the support is enough to render it,
+// otherwise it falls back to unicode and then to ASCII glyphs.
+func resolveGlyphSet(set GlyphSet, support GlyphSupport) GlyphSet {
+	if support == GlyphSupportAuto {
+		support = detectGlyphSupport()
+	}
+
+	switch {
+	case set.Requires <= support:
+		return set
+	case support >= GlyphSupportUnicode:
+		return UnicodeGlyphs()
+	default:
+		return ASCIIGlyphs()
+	}
+}
+
+// detectGlyphSupport detects the glyph support from the environment.
+// Nerd fonts can not be detected, they have to be enabled by the override.
+func detectGlyphSupport() GlyphSupport {
+	switch strings.ToLower(os.Getenv(glyphSupportEnv)) {
+	case "ascii":
+		return GlyphSupportASCII
+	case "unicode":
+		return GlyphSupportUnicode
+	case "nerdfont", "nerd-font", "powerline":
+		return GlyphSupportNerdFont
+	}
+
+	switch os.Getenv("TERM") {
+	case "linux", "dumb", "vt100", "vt220":
+		return GlyphSupportASCII
+	}
+
+	for _, env := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if locale := os.Getenv(env); locale != "" {
+			locale = strings.ToLower(locale)
+			if strings.Contains(locale, "utf-8") || strings.Contains(locale, "utf8") {
+				return GlyphSupportUnicode
+			}
+			return GlyphSupportASCII
+		}
+	}
+
+	return GlyphSupportUnicode
+}
+
+// SetGlyphSet sets the glyphs used to draw the Skeleton. If the terminal does not
+// support the given set, it falls back to unicode or ASCII glyphs.
+func (s *Skeleton) SetGlyphSet(set GlyphSet) *Skeleton {
+	s.properties.requestedGlyphs = set
+	s.applyGlyphs()
+	return s
+}
+
+// SetGlyphSupport overrides the glyph support detection. GlyphSupportAuto restores the detection.
+func (s *Skeleton) SetGlyphSupport(support GlyphSupport) *Skeleton {
+	s.properties.glyphSupport = support
+	s.applyGlyphs()
+	return s
+}
+
+// SetTabBorders sets the borders of the active, inactive and disabled tabs, e.g. lipgloss.NormalBorder()
+// for square tabs, lipgloss.HiddenBorder() for tabs without chrome or a completely custom border.
+// The left and right sides are replaced by the joins, see SetTabJoins. The glyph fallback still applies.
+func (s *Skeleton) SetTabBorders(active, inactive, disabled lipgloss.Border) *Skeleton {
+	s.properties.requestedGlyphs.ActiveTab = active
+	s.properties.requestedGlyphs.InactiveTab = inactive
+	s.properties.requestedGlyphs.DisabledTab = disabled
+	s.applyGlyphs()
+	return s
+}
+
+// GetTabBorders returns the borders of the active, inactive and disabled tabs in use.
+func (s *Skeleton) GetTabBorders() (active, inactive, disabled lipgloss.Border) {
+	glyphs := s.properties.glyphs
+	disabled = glyphs.DisabledTab
+	if disabled == (lipgloss.Border{}) {
+		disabled = glyphs.InactiveTab
+	}
+	return glyphs.ActiveTab, glyphs.InactiveTab, disabled
+}
+
+// SetTabJoins sets the glyphs which join the tabs to the header line, e.g. "┤" and "├".
+// Use spaces for tabs which are not joined to the line.
+func (s *Skeleton) SetTabJoins(left, right string) *Skeleton {
+	s.properties.requestedGlyphs.TabLeft = left
+	s.properties.requestedGlyphs.TabRight = right
+	s.applyGlyphs()
+	return s
+}
+
+// GetGlyphSet returns the glyph set in use, after the fallback is applied.
+func (s *Skeleton) GetGlyphSet() GlyphSet {
+	return s.properties.glyphs
+}
+
+// applyGlyphs resolves the requested glyph set and applies it to the header and widgets.
+func (s *Skeleton) applyGlyphs() {
+	glyphs := resolveGlyphSet(s.properties.requestedGlyphs, s.properties.glyphSupport)
+	if s.GetWindowsHost() == WindowsHostConhost {
+		glyphs = squareGlyphs(glyphs)
+	}
+	s.properties.glyphs = glyphs
+	s.header.SetGlyphs(glyphs, s.isHeaderAtBottom())
+	s.widget.SetGlyphs(glyphs, s.isHeaderAtBottom())
+	s.updater.Update()
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 // header is a helper for rendering the header of the terminal.
@@ -35,17 +36,108 @@ type header struct {
 
 	// lockedTabs holds the keys of individually locked tabs
 	lockedTabs map[string]bool
+
+	// lockReasons are hold the reasons of the locked tabs by their keys
+	lockReasons map[string]string
+
+	// disabledTabs are hold the reasons of the disabled tabs by their keys, the reason may be empty
+	disabledTabs map[string]string
+
+	// allowedTabs are hold the keys of the tabs the permissions allow switching to, nil allows all of them
+	allowedTabs map[string]bool
+
+	// hoveredTab is hold the index of the tab under the mouse, -1 if none
+	hoveredTab int
+
+	// renderer replaces the built-in tab bar when it is set
+	renderer HeaderRenderer
+
+	// scrollOffset is hold the position of the first visible scrolling tab
+	scrollOffset int
+
+	// rightContent is rendered flush-right on the header line, e.g. the name of the application or a clock
+	rightContent string
+
+	// compact renders the tabs embedded into the top border of the frame, on a single line
+	compact bool
+
+	// hidden removes the tab strip, only the top border of the frame is rendered
+	hidden bool
+
+	// sidebarOffset is hold the position of the first visible tab of the sidebar
+	sidebarOffset int
+
+	// stickyTabs holds the keys of the tabs pinned to an edge of the scrolling header
+	stickyTabs map[string]StickySide
+
+	// newTabButton is control the "+" element is shown at the end of the tabs or not
+	newTabButton bool
+
+	// closableTabs holds the keys of the tabs which render a close glyph
+	closableTabs map[string]bool
+
+	// tabColors are hold the border colors of the tabs by their keys
+	tabColors map[string]tabColor
+
+	// tabIcons are hold the icons rendered before the titles of the tabs by their keys
+	tabIcons map[string]string
+
+	// tabSuffixes are hold the segments rendered in the {suffix} segment of the tab templates by their keys
+	tabSuffixes map[string]string
+
+	// tabTemplates are hold the templates of the tab titles by their keys, see defaultTabTemplate
+	tabTemplates map[string]string
+
+	// tabDescriptions are hold the descriptions of the tabs by their keys, the active one is shown in the footer
+	tabDescriptions map[string]string
+
+	// tabSubtitles are hold the subtitles of the tabs by their keys, the active one is shown under the tab bar
+	tabSubtitles map[string]string
+
+	// tabBadges are hold the counts rendered after the titles of the tabs by their keys
+	tabBadges map[string]int
+
+	// tabWorkspaces are hold the workspaces of the tabs by their keys
+	tabWorkspaces map[string]string
+
+	// activeWorkspace is the workspace whose tabs are shown, all tabs are shown if it is empty
+	activeWorkspace string
+
+	// hoveredClose is hold the index of the tab whose close glyph is under the mouse, -1 if none
+	hoveredClose int
+
+	// hitBoxes are hold the horizontal ranges of the rendered tabs, they are updated on every render
+	hitBoxes []headerHitBox
 }
 
-// newHeader returns a new header.
-func newHeader() *header {
+// newHeader returns a new header which shares the viewport, the key bindings and the updater of its Skeleton.
+func newHeader(vp *viewport.Model, keyMap *keyMap, updater *Updater) *header {
 	return &header{
 		properties: defaultHeaderProperties(),
-		viewport:   newTerminalViewport(),
+		viewport:   vp,
 		currentTab: 0,
-		keyMap:     newKeyMap(),
-		updater:    NewUpdater(),
+		keyMap:     keyMap,
+		updater:    updater,
 		lockedTabs: make(map[string]bool),
+		stickyTabs: make(map[string]StickySide),
+
+		lockReasons:  make(map[string]string),
+		disabledTabs: make(map[string]string),
+		hoveredTab:   -1,
+
+		closableTabs: make(map[string]bool),
+		tabColors:    make(map[string]tabColor),
+		tabIcons:     make(map[string]string),
+		tabBadges:    make(map[string]int),
+		hoveredClose: -1,
+
+		tabDescriptions: make(map[string]string),
+		tabSubtitles:    make(map[string]string),
+
+		tabSuffixes:  make(map[string]string),
+		tabTemplates: make(map[string]string),
+
+		tabWorkspaces: make(map[string]string),
 	}
 }
 
@@ -57,6 +149,34 @@ type headerProperties struct {
 	titleStyleActive   lipgloss.Style
 	titleStyleInactive lipgloss.Style
 	titleStyleDisabled lipgloss.Style
+	glyphs             GlyphSet
+
+	// rendererGlyphs is hold the glyphs given to the custom renderer, they are not mirrored since its view is not flipped
+	rendererGlyphs GlyphSet
+
+	// titleStyleUnavailable is the style of the disabled tabs, titleStyleDisabled is the one of the locked tabs
+	titleStyleUnavailable lipgloss.Style
+
+	// scrollable shows a window of the tabs when they do not fit, instead of hiding the header
+	scrollable bool
+
+	// centerActiveTab keeps the active tab centered while scrolling, otherwise the window shifts only when needed
+	centerActiveTab bool
+
+	// tabsPerPage is hold the number of tabs per page of the paginated tab bar, 0 shows all the tabs
+	tabsPerPage int
+
+	// tabMaxWidth is hold the maximum width of the tab titles, longer titles are truncated with "…", 0 is unlimited
+	tabMaxWidth int
+
+	// tabLayout is hold the way the tabs are rendered, horizontal in the header or as a sidebar
+	tabLayout TabLayoutMode
+
+	// sidebarWidth is hold the width of the sidebar, without its separator
+	sidebarWidth int
+
+	// tabTemplate is hold the template of the tabs which have no template of their own
+	tabTemplate string
 }
 
 // defaultHeaderProperties returns the default properties of the header.
@@ -64,34 +184,28 @@ func defaultHeaderProperties() *headerProperties {
 	borderColor := "39"
 	leftPadding := 2
 	rightPadding := 2
+	glyphs := UnicodeGlyphs()
 	return &headerProperties{
 		borderColor:     borderColor,
 		leftTabPadding:  leftPadding,
 		rightTabPadding: rightPadding,
-		titleStyleActive: func() lipgloss.Style {
-			b := lipgloss.DoubleBorder()
-			b.Right = "├"
-			b.Left = "┤"
-			return lipgloss.NewStyle().BorderStyle(b).
-				PaddingLeft(leftPadding).PaddingRight(rightPadding).
-				BorderForeground(lipgloss.Color("205"))
-		}(),
-		titleStyleInactive: func() lipgloss.Style {
-			b := lipgloss.RoundedBorder()
-			b.Right = "├"
-			b.Left = "┤"
-			return lipgloss.NewStyle().BorderStyle(b).
-				PaddingLeft(leftPadding).PaddingRight(rightPadding).
-				BorderForeground(lipgloss.Color("255"))
-		}(),
-		titleStyleDisabled: func() lipgloss.Style {
-			b := lipgloss.RoundedBorder()
-			b.Right = "├"
-			b.Left = "┤"
-			return lipgloss.NewStyle().BorderStyle(b).
-				PaddingLeft(leftPadding).PaddingRight(rightPadding).
-				BorderForeground(lipgloss.Color("240")).Foreground(lipgloss.Color("240"))
-		}(),
+		glyphs:          glyphs,
+		rendererGlyphs:  glyphs,
+		centerActiveTab: true,
+		sidebarWidth:    defaultSidebarWidth,
+		tabTemplate:     defaultTabTemplate,
+		titleStyleActive: lipgloss.NewStyle().BorderStyle(glyphs.activeTabBorder()).
+			PaddingLeft(leftPadding).PaddingRight(rightPadding).
+			BorderForeground(lipgloss.Color("205")),
+		titleStyleInactive: lipgloss.NewStyle().BorderStyle(glyphs.inactiveTabBorder()).
+			PaddingLeft(leftPadding).PaddingRight(rightPadding).
+			BorderForeground(lipgloss.Color("255")),
+		titleStyleDisabled: lipgloss.NewStyle().BorderStyle(glyphs.disabledTabBorder()).
+			PaddingLeft(leftPadding).PaddingRight(rightPadding).
+			BorderForeground(lipgloss.Color("240")).Foreground(lipgloss.Color("240")),
+		titleStyleUnavailable: lipgloss.NewStyle().BorderStyle(glyphs.disabledTabBorder()).
+			PaddingLeft(leftPadding).PaddingRight(rightPadding).
+			BorderForeground(lipgloss.Color("238")).Foreground(lipgloss.Color("242")).Strikethrough(true),
 	}
 }
 
@@ -132,16 +246,25 @@ type HeaderSizeMsg struct {
 // calculateTitleLength calculates the length of the title.
 func (h *header) calculateTitleLength() tea.Cmd {
 	var titleLen int
-	for _, hdr := range h.headers {
-		titleLen += len([]rune(hdr.title))
+	for i, hdr := range h.headers {
+		if !h.isVisible(i) {
+			continue
+		}
+		titleLen += h.titleWidth(hdr)
 		titleLen += h.properties.leftTabPadding + h.properties.rightTabPadding
 		titleLen += 2 // for the border between titles
 	}
+	if h.isPaginated() {
+		titleLen = h.paginatedWidth()
+	}
+	if h.newTabButton {
+		titleLen += h.newTabButtonWidth()
+	}
 
 	requiredLineCountForLine := h.viewport.Width - (titleLen + 2)
 
 	h.titleLength = titleLen
-	if requiredLineCountForLine < 0 {
+	if requiredLineCountForLine < 0 && h.renderer == nil && !h.properties.scrollable && !h.isSidebar() && !h.hidden && !h.compact {
 		return func() tea.Msg {
 			return HeaderSizeMsg{NotEnoughToHandleHeaders: false}
 		}
@@ -158,35 +281,186 @@ func (h *header) View() string {
 		return "setting up terminal..."
 	}
 
-	requiredLineCount := h.viewport.Width - (h.titleLength + 2)
+	if h.hidden || h.isSidebar() {
+		return h.frameLineView()
+	}
+
+	if h.renderer != nil {
+		return h.renderer.RenderHeader(h.headerState())
+	}
+
+	if h.compact {
+		return h.compactHeaderView()
+	}
+
+	usedWidth := h.titleLength
+	var layout scrollLayout
+	var pages [][]int
+	var page int
+	switch {
+	case h.isPaginated():
+		pages = h.tabPages()
+		page = h.activeTabPage(pages)
+		usedWidth = ansi.StringWidth(h.tabPageIndicator(page, len(pages)))
+		if len(pages) > 0 {
+			usedWidth += h.indexesWidth(pages[page])
+		}
+		if h.newTabButton {
+			usedWidth += h.newTabButtonWidth()
+		}
+	case h.isScrolling():
+		layout = h.scrollLayout()
+		usedWidth = h.layoutWidth(layout)
+	}
+
+	requiredLineCount := h.viewport.Width - (usedWidth + 2)
 
 	if requiredLineCount < 0 {
 		return ""
 	}
 
-	line := strings.Repeat("─", requiredLineCount)
-	line = lipgloss.NewStyle().Foreground(lipgloss.Color(h.properties.borderColor)).Render(line)
+	frame := h.properties.glyphs.Frame
+	line := h.fillLine(requiredLineCount)
 
-	var renderedTitles []string
+	// hit boxes are recorded while rendering, x starts after the left corner
+	h.hitBoxes = h.hitBoxes[:0]
+	x := 1
+	appendTitle := func(titles []string, rendered string, index int) []string {
+		width := lipgloss.Width(rendered)
+		if index != hitBoxNone {
+			h.hitBoxes = append(h.hitBoxes, headerHitBox{index: index, start: x, end: x + width})
+		}
+		x += width
+		return append(titles, rendered)
+	}
+
+	var renderedTitles, trailingTitles []string
 	renderedTitles = append(renderedTitles, "")
-	for i, hdr := range h.headers {
-		if i == h.currentTab {
-			renderedTitles = append(renderedTitles, h.properties.titleStyleActive.Render(hdr.title))
-		} else {
-			if h.GetLockTabs() || h.IsTabLocked(hdr.key) {
-				renderedTitles = append(renderedTitles, h.properties.titleStyleDisabled.Render(hdr.title))
-			} else {
-				renderedTitles = append(renderedTitles, h.properties.titleStyleInactive.Render(hdr.title))
+	if h.isPaginated() {
+		if len(pages) > 0 {
+			for _, i := range pages[page] {
+				renderedTitles = appendTitle(renderedTitles, h.renderTab(i), i)
 			}
 		}
+		if indicator := h.tabPageIndicator(page, len(pages)); indicator != "" {
+			renderedTitles = appendTitle(renderedTitles, indicator, hitBoxNone)
+		}
+	} else if h.isScrolling() {
+		for _, i := range layout.left {
+			renderedTitles = appendTitle(renderedTitles, h.renderTab(i), i)
+		}
+		renderedTitles = appendTitle(renderedTitles, h.scrollIndicator(layout.hiddenBefore, "‹"), hitBoxNone)
+		for _, i := range layout.window {
+			renderedTitles = appendTitle(renderedTitles, h.renderTab(i), i)
+		}
+		renderedTitles = appendTitle(renderedTitles, h.scrollIndicator(layout.hiddenAfter, "›"), hitBoxNone)
+	} else {
+		for i := range h.headers {
+			if h.isVisible(i) {
+				renderedTitles = appendTitle(renderedTitles, h.renderTab(i), i)
+			}
+		}
+	}
+	if h.newTabButton {
+		renderedTitles = appendTitle(renderedTitles, h.properties.titleStyleInactive.Render(newTabButtonTitle), hitBoxNewTab)
 	}
 
-	leftCorner := lipgloss.JoinVertical(lipgloss.Top, "╭", "│")
-	rightCorner := lipgloss.JoinVertical(lipgloss.Top, "╮", "│")
+	// the right sticky tabs are placed after the line, at the right edge
+	x += requiredLineCount
+	for _, i := range layout.right {
+		trailingTitles = appendTitle(trailingTitles, h.renderTab(i), i)
+	}
+
+	leftCorner := lipgloss.JoinVertical(lipgloss.Top, frame.TopLeft, frame.Left)
+	rightCorner := lipgloss.JoinVertical(lipgloss.Top, frame.TopRight, frame.Right)
 	leftCorner = lipgloss.NewStyle().Foreground(lipgloss.Color(h.properties.borderColor)).Render(leftCorner)
 	rightCorner = lipgloss.NewStyle().Foreground(lipgloss.Color(h.properties.borderColor)).Render(rightCorner)
 
-	return lipgloss.JoinHorizontal(lipgloss.Bottom, leftCorner, lipgloss.JoinHorizontal(lipgloss.Center, append(renderedTitles, line)...), rightCorner)
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, leftCorner, lipgloss.JoinHorizontal(lipgloss.Center, append(append(renderedTitles, line), trailingTitles...)...), rightCorner)
+}
+
+// frameLineView renders only the top border of the frame, it is used when the tabs are not in the header.
+func (h *header) frameLineView() string {
+	h.hitBoxes = h.hitBoxes[:0]
+	frame := h.properties.glyphs.Frame
+	borderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(h.properties.borderColor))
+	return borderStyle.Render(frame.TopLeft) + h.fillLine(h.viewport.Width-2) + borderStyle.Render(frame.TopRight)
+}
+
+// fillLine renders the header line after the tabs with the given width, the right content is embedded
+// flush-right into it. The content is truncated to the width, or omitted if there is no room for it.
+func (h *header) fillLine(width int) string {
+	borderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(h.properties.borderColor))
+	frame := h.properties.glyphs.Frame
+
+	width = max(width, 0)
+
+	// the content needs the leading line, a space on both sides and the trailing line
+	if h.rightContent == "" || width < 5 {
+		return borderStyle.Render(strings.Repeat(frame.Top, width))
+	}
+
+	content := " " + truncateText(h.rightContent, width-4) + " "
+	rest := width - 1 - lipgloss.Width(content)
+	return borderStyle.Render(strings.Repeat(frame.Top, rest)) + content + borderStyle.Render(frame.Top)
+}
+
+// renderTab renders the tab at the given index with the style of its state.
+func (h *header) renderTab(i int) string {
+	hdr := h.headers[i]
+	title := h.tabLabel(hdr)
+	if h.showsCloseGlyph(hdr.key) {
+		closeStyle := lipgloss.NewStyle()
+		if h.hoveredClose == i {
+			closeStyle = closeStyle.Foreground(lipgloss.Color("196")).Bold(true)
+		}
+		title += " " + closeStyle.Render(closeGlyph)
+	}
+
+	switch {
+	case i == h.currentTab:
+		return h.tabStyle(h.properties.titleStyleActive, hdr.key, true).Render(title)
+	case h.isTabUnavailable(hdr.key):
+		return h.properties.titleStyleUnavailable.Render(title)
+	case h.GetLockTabs() || h.IsTabLocked(hdr.key):
+		return h.properties.titleStyleDisabled.Render(title)
+	default:
+		return h.tabStyle(h.properties.titleStyleInactive, hdr.key, false).Render(title)
+	}
+}
+
+// titleWidth returns the width of the title of the tab, with its icon and the close glyph if it is closable.
+func (h *header) titleWidth(hdr commonHeader) int {
+	width := ansi.StringWidth(h.tabLabel(hdr))
+	if h.showsCloseGlyph(hdr.key) {
+		width += 1 + ansi.StringWidth(closeGlyph)
+	}
+	return width
+}
+
+// displayTitle returns the title of the tab, truncated to the maximum tab width.
+func (h *header) displayTitle(hdr commonHeader) string {
+	if h.properties.tabMaxWidth <= 0 {
+		return hdr.title
+	}
+	return truncateText(hdr.title, h.properties.tabMaxWidth)
+}
+
+// SetTabMaxWidth sets the maximum width of the tab titles. Zero removes the limit.
+func (h *header) SetTabMaxWidth(width int) {
+	if width < 0 {
+		width = 0
+	}
+	h.properties.tabMaxWidth = width
+	h.calculateTitleLength()
+}
+
+// newTabButtonTitle is the title of the "+" element.
+const newTabButtonTitle = "+"
+
+// newTabButtonWidth returns the rendered width of the "+" element.
+func (h *header) newTabButtonWidth() int {
+	return ansi.StringWidth(newTabButtonTitle) + h.properties.leftTabPadding + h.properties.rightTabPadding + 2
 }
 
 // SetLeftPadding sets the left padding of the header.
@@ -195,6 +469,7 @@ func (h *header) SetLeftPadding(padding int) {
 	h.properties.titleStyleActive = h.properties.titleStyleActive.PaddingLeft(padding)
 	h.properties.titleStyleInactive = h.properties.titleStyleInactive.PaddingLeft(padding)
 	h.properties.titleStyleDisabled = h.properties.titleStyleDisabled.PaddingLeft(padding)
+	h.properties.titleStyleUnavailable = h.properties.titleStyleUnavailable.PaddingLeft(padding)
 
 	h.calculateTitleLength()
 }
@@ -205,6 +480,7 @@ func (h *header) SetRightPadding(padding int) {
 	h.properties.titleStyleActive = h.properties.titleStyleActive.PaddingRight(padding)
 	h.properties.titleStyleInactive = h.properties.titleStyleInactive.PaddingRight(padding)
 	h.properties.titleStyleDisabled = h.properties.titleStyleDisabled.PaddingRight(padding)
+	h.properties.titleStyleUnavailable = h.properties.titleStyleUnavailable.PaddingRight(padding)
 
 	h.calculateTitleLength()
 }
@@ -234,6 +510,28 @@ func (h *header) SetBorderColor(color string) {
 	h.properties.borderColor = color
 }
 
+// SetGlyphs sets the glyphs used to draw the header. When the header is flipped to the bottom, it is drawn
+// with the mirrored glyphs so that the borders look right once its lines are reversed.
+func (h *header) SetGlyphs(glyphs GlyphSet, mirrored bool) {
+	h.properties.rendererGlyphs = glyphs
+	if mirrored {
+		glyphs = mirroredGlyphs(glyphs)
+	}
+	h.properties.glyphs = glyphs
+	h.properties.titleStyleActive = h.properties.titleStyleActive.BorderStyle(glyphs.activeTabBorder())
+	h.properties.titleStyleInactive = h.properties.titleStyleInactive.BorderStyle(glyphs.inactiveTabBorder())
+	h.properties.titleStyleDisabled = h.properties.titleStyleDisabled.BorderStyle(glyphs.disabledTabBorder())
+	h.properties.titleStyleUnavailable = h.properties.titleStyleUnavailable.BorderStyle(glyphs.disabledTabBorder())
+
+	h.calculateTitleLength()
+}
+
+// SetRenderer sets the renderer which replaces the built-in tab bar.
+func (h *header) SetRenderer(renderer HeaderRenderer) {
+	h.renderer = renderer
+	h.calculateTitleLength()
+}
+
 // SetCurrentTab sets the current tab index.
 func (h *header) SetCurrentTab(tab int) {
 	h.currentTab = tab
@@ -247,6 +545,7 @@ func (h *header) SetLockTabs(lock bool) {
 		}
 	} else {
 		h.lockedTabs = make(map[string]bool)
+		h.lockReasons = make(map[string]string)
 	}
 	h.updater.Update()
 }
@@ -312,5 +611,6 @@ func (h *header) LockTab(key string) {
 // UnlockTab unlocks a specific tab by its key
 func (h *header) UnlockTab(key string) {
 	delete(h.lockedTabs, key)
+	delete(h.lockReasons, key)
 	h.updater.Update()
 }
@@ -0,0 +1,57 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RegisterPageKeyMap registers the key map of the page by the given key.
+// It is shown in the help overlay while the page is active.
+func (s *Skeleton) RegisterPageKeyMap(key string, keyMap help.KeyMap) *Skeleton {
+	key = s.normalizeKey(key)
+	s.pageKeyMaps[key] = keyMap
+	s.refreshKeyHints()
+	s.updater.Update()
+	return s
+}
+
+// ShowHelp shows or hides the help overlay.
+func (s *Skeleton) ShowHelp(show bool) *Skeleton {
+	s.helpVisible = show
+	s.updater.Update()
+	return s
+}
+
+// IsHelpVisible returns the help overlay is shown or not.
+func (s *Skeleton) IsHelpVisible() bool {
+	return s.helpVisible
+}
+
+// GetKeyMap returns the key map of the Skeleton, it can be rendered with bubbles/help.
+func (s *Skeleton) GetKeyMap() help.KeyMap {
+	return s.KeyMap
+}
+
+// helpView renders the help overlay with the key bindings of the Skeleton and the active page.
+func (s *Skeleton) helpView(maxWidth int) string {
+	h := help.New()
+	h.ShowAll = true
+	h.Width = maxWidth - 6 // for the border and the padding of the box
+
+	sections := []string{
+		lipgloss.NewStyle().Bold(true).Render("Keys"),
+		h.View(s.KeyMap),
+	}
+	if hotkeys := s.pageHotkeyHelp(); len(hotkeys) > 0 {
+		sections = append(sections, "", h.View(hotkeys))
+	}
+	if keyMap, ok := s.pageKeyMaps[s.GetActivePage()]; ok {
+		sections = append(sections, "", h.View(keyMap))
+	}
+
+	return lipgloss.NewStyle().
+		Border(s.properties.glyphs.Frame).
+		BorderForeground(lipgloss.Color(s.properties.borderColor)).
+		Padding(0, 2).
+		Render(lipgloss.JoinVertical(lipgloss.Left, sections...))
+}
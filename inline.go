@@ -0,0 +1,94 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// defaultInlineHeight is the height of the inline mode if it is not set by SetInlineHeight.
+const defaultInlineHeight = 10
+
+// minInlineHeight is the smallest inline height which fits the header, a body line and the footer.
+const minInlineHeight = 3
+
+// screenModeMsg switches between the alternate screen and the inline mode while the program is running.
+type screenModeMsg struct{}
+
+// SetInlineMode renders the Skeleton inline, within the normal terminal scrollback instead of the
+// alternate screen, with the inline height. The last frame stays in the scrollback when the
+// program quits, e.g. for a dashboard above the shell prompt. The header and the footer are
+// rendered compact, on a single line each. It can be switched while the program is running.
+func (s *Skeleton) SetInlineMode(enabled bool) *Skeleton {
+	s.properties.inline = enabled
+	s.header.compact = enabled
+	s.widget.compact = enabled
+	s.updater.UpdateWithMsg(screenModeMsg{})
+	return s
+}
+
+// ToggleInlineMode switches between the inline mode and the alternate screen.
+func (s *Skeleton) ToggleInlineMode() *Skeleton {
+	return s.SetInlineMode(!s.properties.inline)
+}
+
+// IsInlineMode returns the Skeleton is rendered inline or on the alternate screen.
+func (s *Skeleton) IsInlineMode() bool {
+	return s.properties.inline
+}
+
+// SetInlineHeight renders the Skeleton inline as an n-line shell: the compact header, the page content
+// and the compact footer, suitable for embedding in command output. Heights below 3 are raised to 3.
+func (s *Skeleton) SetInlineHeight(n int) *Skeleton {
+	s.properties.inlineHeight = max(n, minInlineHeight)
+	return s.SetInlineMode(true)
+}
+
+// GetInlineHeight returns the height of the Skeleton in the inline mode.
+func (s *Skeleton) GetInlineHeight() int {
+	return s.properties.inlineHeight
+}
+
+// screenMode returns the command which enters or exits the alternate screen.
+func (s *Skeleton) screenMode() tea.Cmd {
+	if s.IsInlineMode() {
+		return tea.ExitAltScreen
+	}
+	return tea.EnterAltScreen
+}
+
+// fitWindowSize limits the height of the given size to the inline height. The last column is
+// left empty on conhost, which wraps the line when it is written.
+func (s *Skeleton) fitWindowSize(msg tea.WindowSizeMsg) tea.WindowSizeMsg {
+	if s.IsInlineMode() {
+		msg.Height = min(msg.Height, s.properties.inlineHeight)
+	}
+	if s.GetWindowsHost() == WindowsHostConhost && msg.Width > 1 {
+		msg.Width--
+	}
+	return msg
+}
+
+// resizeMsg delivers a terminal size which is not reported by the program, e.g. after a resize signal.
+type resizeMsg struct {
+	size tea.WindowSizeMsg
+}
+
+// Resize resizes the Skeleton to the given terminal size, for terminals whose size is not reported
+// by the program, e.g. a remote terminal in a browser. Non-positive sizes are ignored.
+func (s *Skeleton) Resize(width int, height int) *Skeleton {
+	if width > 0 && height > 0 {
+		// the size waits for room in the updater instead of being dropped, the last one must not be lost
+		s.updater.queueWithMsg(s.ctx, resizeMsg{size: tea.WindowSizeMsg{Width: width, Height: height}})
+	}
+	return s
+}
+
+// resizeCmd returns a command which resizes the Skeleton to the last known terminal size.
+func (s *Skeleton) resizeCmd() tea.Cmd {
+	size := s.terminalSize
+	if size.Width <= 0 || size.Height <= 0 {
+		return nil
+	}
+	return func() tea.Msg {
+		return tea.WindowSizeMsg{Width: size.Width, Height: size.Height}
+	}
+}
@@ -0,0 +1,27 @@
+package skeleton
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResizeDoesNotDropSize(t *testing.T) {
+	s, _ := newFullSkeleton(t)
+	t.Cleanup(s.Shutdown)
+	s.Resize(120, 40)
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case msg := <-s.updater.rcv:
+			if resize, ok := msg.(resizeMsg); ok {
+				if resize.size.Width != 120 || resize.size.Height != 40 {
+					t.Errorf("resized to %dx%d, want 120x40", resize.size.Width, resize.size.Height)
+				}
+				return
+			}
+		case <-timeout:
+			t.Fatal("the size is dropped")
+		}
+	}
+}
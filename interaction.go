@@ -0,0 +1,73 @@
+package skeleton
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// InteractionKind is the kind of a recorded interaction.
+type InteractionKind string
+
+const (
+	// InteractionTabSwitched is recorded when another tab becomes active.
+	InteractionTabSwitched InteractionKind = "tab_switched"
+	// InteractionPageOpened is recorded when a page is added.
+	InteractionPageOpened InteractionKind = "page_opened"
+	// InteractionPageClosed is recorded when a page is deleted.
+	InteractionPageClosed InteractionKind = "page_closed"
+	// InteractionCommandRun is recorded when a key binding of the Skeleton is pressed, e.g. "close_page".
+	InteractionCommandRun InteractionKind = "command_run"
+)
+
+// Interaction is a high-level interaction of the user with the Skeleton, for usage analytics.
+type Interaction struct {
+	// Kind is the kind of the interaction
+	Kind InteractionKind
+
+	// Page is the key of the page the interaction is about, the activated one for the tab switches
+	Page string
+
+	// From is the key of the previously active page of the tab switches
+	From string
+
+	// Command is the action name of the key binding of the command runs, see the Action constants
+	Command string
+
+	// Time is when the interaction happened
+	Time time.Time
+}
+
+// InteractionSink receives the recorded interactions. It is called while the Skeleton is updated,
+// so it has to return quickly, e.g. by buffering the interactions or sending them to a channel.
+type InteractionSink func(interaction Interaction)
+
+// SetInteractionSink enables recording the interactions into the given sink, nil disables it.
+// Recording is opt-in, nothing is recorded until a sink is set.
+func (s *Skeleton) SetInteractionSink(sink InteractionSink) *Skeleton {
+	s.interactionSink = sink
+	return s
+}
+
+// recordInteraction sends the interaction to the sink, if there is one.
+func (s *Skeleton) recordInteraction(interaction Interaction) {
+	if s.interactionSink == nil {
+		return
+	}
+	interaction.Time = s.clock.Now()
+	s.interactionSink(interaction)
+}
+
+// recordCommand records the action of the key binding of the Skeleton which matches the given key.
+func (s *Skeleton) recordCommand(msg tea.KeyMsg) {
+	if s.interactionSink == nil {
+		return
+	}
+	for _, action := range s.KeyMap.actions() {
+		if binding := s.KeyMap.binding(action); binding != nil && key.Matches(msg, *binding) {
+			s.recordInteraction(Interaction{Kind: InteractionCommandRun, Page: s.GetActivePage(), Command: action})
+			return
+		}
+	}
+}
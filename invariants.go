@@ -0,0 +1,39 @@
+package skeleton
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CheckInvariants returns an error describing every broken invariant of the Skeleton, nil if there is none.
+// The headers and the pages have to be consistent and the current tab has to be in bounds.
+// It is meant for tests and fuzzing of applications, see FuzzUpdate.
+func (s *Skeleton) CheckInvariants() error {
+	var errs []error
+
+	headers := s.header.headers
+	if len(headers) != len(s.pages) {
+		errs = append(errs, fmt.Errorf("skeleton: %d headers but %d pages", len(headers), len(s.pages)))
+	}
+
+	seen := make(map[string]bool, len(headers))
+	for i, hdr := range headers {
+		if seen[hdr.key] {
+			errs = append(errs, fmt.Errorf("skeleton: duplicate page key %q at %d", hdr.key, i))
+		}
+		seen[hdr.key] = true
+	}
+
+	current := s.header.GetCurrentTab()
+	switch {
+	case len(headers) == 0 && current != 0:
+		errs = append(errs, fmt.Errorf("skeleton: current tab %d without pages", current))
+	case len(headers) > 0 && (current < 0 || current >= len(headers)):
+		errs = append(errs, fmt.Errorf("skeleton: current tab %d out of %d pages", current, len(headers)))
+	}
+	if s.currentTab != current {
+		errs = append(errs, fmt.Errorf("skeleton: current tab %d of the skeleton differs from %d of the header", s.currentTab, current))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,72 @@
+package skeleton
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// PageKeyMap is implemented by the pages which expose their key bindings, the key hint widget shows them
+// while the page is active.
+type PageKeyMap interface {
+	// KeyBindings returns the key bindings of the page in the order they are hinted.
+	KeyBindings() []key.Binding
+}
+
+// keyHintWidget is the hint line of the key bindings of the active page, e.g. "↑/↓ navigate • enter open".
+type keyHintWidget struct {
+	bindings func() []key.Binding
+}
+
+// format returns the enabled bindings with their help, separated by bullets.
+func (w *keyHintWidget) format(ascii bool) string {
+	separator := " • "
+	if ascii {
+		separator = " | "
+	}
+
+	var hints []string
+	for _, binding := range w.bindings() {
+		help := binding.Help()
+		if !binding.Enabled() || help.Key == "" {
+			continue
+		}
+		hints = append(hints, strings.TrimSpace(help.Key+" "+help.Desc))
+	}
+	return strings.Join(hints, separator)
+}
+
+// AddKeyHintWidget adds a widget with the hint line of the key bindings of the active page. The bindings
+// are taken from the page if it implements PageKeyMap, otherwise from the short help of the key map
+// registered by RegisterPageKeyMap. The hints are updated when the tab is switched and after the page
+// handles a message, so the bindings the page disables disappear.
+func (s *Skeleton) AddKeyHintWidget(key string) *Skeleton {
+	return s.addKindWidget(key, &keyHintWidget{bindings: s.activePageBindings})
+}
+
+// activePageBindings returns the key bindings of the active page.
+func (s *Skeleton) activePageBindings() []key.Binding {
+	if s.currentTab < 0 || s.currentTab >= len(s.pages) {
+		return nil
+	}
+	if keyMap, ok := s.pages[s.currentTab].(PageKeyMap); ok {
+		return keyMap.KeyBindings()
+	}
+	if keyMap, ok := s.pageKeyMaps[s.header.headers[s.currentTab].key]; ok {
+		return keyMap.ShortHelp()
+	}
+	return nil
+}
+
+// refreshKeyHints formats the key hint widgets again if the bindings of the active page changed.
+func (s *Skeleton) refreshKeyHints() {
+	for _, wgt := range s.widget.widgets {
+		hints, ok := wgt.kind.(*keyHintWidget)
+		if !ok {
+			continue
+		}
+		if value := hints.format(s.widget.isASCII()); value != wgt.Value {
+			s.widget.updateWidgetContent(wgt.Key, value)
+		}
+	}
+}
@@ -1,42 +1,140 @@
 package skeleton
 
 import (
+	"encoding/json"
+	"fmt"
 	teakey "github.com/charmbracelet/bubbles/key"
-	"sync"
+	"io"
+	"strconv"
+	"strings"
 )
 
 type keyMap struct {
 	SwitchTabRight teakey.Binding
 	SwitchTabLeft  teakey.Binding
+	MovePageRight  teakey.Binding
+	MovePageLeft   teakey.Binding
 	Quit           teakey.Binding
+	ClosePage      teakey.Binding
+	ReopenPage     teakey.Binding
+	HistoryBack    teakey.Binding
+	HistoryForward teakey.Binding
+	CycleTheme     teakey.Binding
+	Help           teakey.Binding
+	NewTab         teakey.Binding
+	CycleWorkspace teakey.Binding
+	ToggleHeader   teakey.Binding
+	ToggleWidgets  teakey.Binding
+
+	// NextTabPage and PrevTabPage flip the pages of the paginated tab bar, see SetTabsPerPage
+	NextTabPage teakey.Binding
+	PrevTabPage teakey.Binding
+
+	// CloseOtherPages and ClosePagesToTheRight act on the active page
+	CloseOtherPages      teakey.Binding
+	ClosePagesToTheRight teakey.Binding
+
+	// JumpToTab activates the Nth tab, JumpToTab[0] is for the first tab
+	JumpToTab []teakey.Binding
 }
 
 const (
 	keymapSwitchTabRight = "ctrl+right"
 	keymapSwitchTabLeft  = "ctrl+left"
+	keymapMovePageRight  = "ctrl+shift+right"
+	keymapMovePageLeft   = "ctrl+shift+left"
 	keymapQuit           = "ctrl+c"
-)
+	keymapClosePage      = "ctrl+w"
+	keymapReopenPage     = "alt+t"
+	keymapHistoryBack    = "alt+left"
+	keymapHistoryForward = "alt+right"
+	keymapJumpToTab      = "alt+%d"
+	keymapHelp           = "f1"
+	keymapNewTab         = "ctrl+t"
+	keymapCycleWorkspace = "alt+w"
+	keymapNextTabPage    = "alt+pgdown"
+	keymapPrevTabPage    = "alt+pgup"
 
-var (
-	onceKeyMap sync.Once
-	varKeyMap  *keyMap
+	// jumpToTabCount is the number of the default jump to tab bindings
+	jumpToTabCount = 9
 )
 
 func newKeyMap() *keyMap {
-	onceKeyMap.Do(func() {
-		varKeyMap = &keyMap{
-			SwitchTabRight: teakey.NewBinding(
-				teakey.WithKeys(keymapSwitchTabRight),
-			),
-			SwitchTabLeft: teakey.NewBinding(
-				teakey.WithKeys(keymapSwitchTabLeft),
-			),
-			Quit: teakey.NewBinding(
-				teakey.WithKeys(keymapQuit),
-			),
-		}
-	})
-	return varKeyMap
+	km := &keyMap{
+		SwitchTabRight: teakey.NewBinding(
+			teakey.WithKeys(keymapSwitchTabRight),
+			teakey.WithHelp(keymapSwitchTabRight, "next tab"),
+		),
+		SwitchTabLeft: teakey.NewBinding(
+			teakey.WithKeys(keymapSwitchTabLeft),
+			teakey.WithHelp(keymapSwitchTabLeft, "previous tab"),
+		),
+		MovePageRight: teakey.NewBinding(
+			teakey.WithKeys(keymapMovePageRight),
+			teakey.WithHelp(keymapMovePageRight, "move tab right"),
+		),
+		MovePageLeft: teakey.NewBinding(
+			teakey.WithKeys(keymapMovePageLeft),
+			teakey.WithHelp(keymapMovePageLeft, "move tab left"),
+		),
+		Quit: teakey.NewBinding(
+			teakey.WithKeys(keymapQuit),
+			teakey.WithHelp(keymapQuit, "quit"),
+		),
+		ClosePage: teakey.NewBinding(
+			teakey.WithKeys(keymapClosePage),
+			teakey.WithHelp(keymapClosePage, "close tab"),
+		),
+		ReopenPage: teakey.NewBinding(
+			teakey.WithKeys(keymapReopenPage),
+			teakey.WithHelp(keymapReopenPage, "reopen closed tab"),
+		),
+		HistoryBack: teakey.NewBinding(
+			teakey.WithKeys(keymapHistoryBack),
+			teakey.WithHelp(keymapHistoryBack, "back"),
+		),
+		HistoryForward: teakey.NewBinding(
+			teakey.WithKeys(keymapHistoryForward),
+			teakey.WithHelp(keymapHistoryForward, "forward"),
+		),
+		// CycleTheme is optional, it has no keys by default
+		CycleTheme: teakey.NewBinding(teakey.WithHelp("", "cycle theme")),
+		Help: teakey.NewBinding(
+			teakey.WithKeys(keymapHelp),
+			teakey.WithHelp(keymapHelp, "toggle help"),
+		),
+		NewTab: teakey.NewBinding(
+			teakey.WithKeys(keymapNewTab),
+			teakey.WithHelp(keymapNewTab, "new tab"),
+		),
+		CycleWorkspace: teakey.NewBinding(
+			teakey.WithKeys(keymapCycleWorkspace),
+			teakey.WithHelp(keymapCycleWorkspace, "next workspace"),
+		),
+		// ToggleHeader is optional, it has no keys by default
+		ToggleHeader: teakey.NewBinding(teakey.WithHelp("", "toggle tabs")),
+		// ToggleWidgets is optional, it has no keys by default
+		ToggleWidgets: teakey.NewBinding(teakey.WithHelp("", "toggle widgets")),
+		NextTabPage: teakey.NewBinding(
+			teakey.WithKeys(keymapNextTabPage),
+			teakey.WithHelp(keymapNextTabPage, "next tab page"),
+		),
+		PrevTabPage: teakey.NewBinding(
+			teakey.WithKeys(keymapPrevTabPage),
+			teakey.WithHelp(keymapPrevTabPage, "previous tab page"),
+		),
+		// CloseOtherPages and ClosePagesToTheRight are optional, they have no keys by default
+		CloseOtherPages:      teakey.NewBinding(teakey.WithHelp("", "close other tabs")),
+		ClosePagesToTheRight: teakey.NewBinding(teakey.WithHelp("", "close tabs to the right")),
+		JumpToTab:            make([]teakey.Binding, jumpToTabCount),
+	}
+	for i := range km.JumpToTab {
+		km.JumpToTab[i] = teakey.NewBinding(
+			teakey.WithKeys(fmt.Sprintf(keymapJumpToTab, i+1)),
+			teakey.WithHelp(fmt.Sprintf(keymapJumpToTab, i+1), fmt.Sprintf("tab %d", i+1)),
+		)
+	}
+	return km
 }
 
 // --------------------------------------------
@@ -49,10 +147,85 @@ func (k *keyMap) SetKeyPrevTab(keybinding teakey.Binding) {
 	k.SwitchTabLeft = keybinding
 }
 
+func (k *keyMap) SetKeyMovePageRight(keybinding teakey.Binding) {
+	k.MovePageRight = keybinding
+}
+
+func (k *keyMap) SetKeyMovePageLeft(keybinding teakey.Binding) {
+	k.MovePageLeft = keybinding
+}
+
 func (k *keyMap) SetKeyQuit(keybinding teakey.Binding) {
 	k.Quit = keybinding
 }
 
+func (k *keyMap) SetKeyClosePage(keybinding teakey.Binding) {
+	k.ClosePage = keybinding
+}
+
+func (k *keyMap) SetKeyReopenPage(keybinding teakey.Binding) {
+	k.ReopenPage = keybinding
+}
+
+func (k *keyMap) SetKeyHistoryBack(keybinding teakey.Binding) {
+	k.HistoryBack = keybinding
+}
+
+func (k *keyMap) SetKeyHistoryForward(keybinding teakey.Binding) {
+	k.HistoryForward = keybinding
+}
+
+func (k *keyMap) SetKeyCycleTheme(keybinding teakey.Binding) {
+	k.CycleTheme = keybinding
+}
+
+func (k *keyMap) SetKeyHelp(keybinding teakey.Binding) {
+	k.Help = keybinding
+}
+
+func (k *keyMap) SetKeyNewTab(keybinding teakey.Binding) {
+	k.NewTab = keybinding
+}
+
+func (k *keyMap) SetKeyCycleWorkspace(keybinding teakey.Binding) {
+	k.CycleWorkspace = keybinding
+}
+
+func (k *keyMap) SetKeyToggleHeader(keybinding teakey.Binding) {
+	k.ToggleHeader = keybinding
+}
+
+func (k *keyMap) SetKeyToggleWidgets(keybinding teakey.Binding) {
+	k.ToggleWidgets = keybinding
+}
+
+func (k *keyMap) SetKeyNextTabPage(keybinding teakey.Binding) {
+	k.NextTabPage = keybinding
+}
+
+func (k *keyMap) SetKeyPrevTabPage(keybinding teakey.Binding) {
+	k.PrevTabPage = keybinding
+}
+
+func (k *keyMap) SetKeyCloseOtherPages(keybinding teakey.Binding) {
+	k.CloseOtherPages = keybinding
+}
+
+func (k *keyMap) SetKeyClosePagesToTheRight(keybinding teakey.Binding) {
+	k.ClosePagesToTheRight = keybinding
+}
+
+// SetKeyJumpToTab sets the key binding which activates the tab at the given index.
+func (k *keyMap) SetKeyJumpToTab(index int, keybinding teakey.Binding) {
+	if index < 0 {
+		return
+	}
+	for len(k.JumpToTab) <= index {
+		k.JumpToTab = append(k.JumpToTab, teakey.NewBinding())
+	}
+	k.JumpToTab[index] = keybinding
+}
+
 func (k *keyMap) GetKeyNextTab() teakey.Binding {
 	return k.SwitchTabRight
 }
@@ -61,6 +234,299 @@ func (k *keyMap) GetKeyPrevTab() teakey.Binding {
 	return k.SwitchTabLeft
 }
 
+func (k *keyMap) GetKeyMovePageRight() teakey.Binding {
+	return k.MovePageRight
+}
+
+func (k *keyMap) GetKeyMovePageLeft() teakey.Binding {
+	return k.MovePageLeft
+}
+
 func (k *keyMap) GetKeyQuit() teakey.Binding {
 	return k.Quit
 }
+
+func (k *keyMap) GetKeyClosePage() teakey.Binding {
+	return k.ClosePage
+}
+
+func (k *keyMap) GetKeyReopenPage() teakey.Binding {
+	return k.ReopenPage
+}
+
+func (k *keyMap) GetKeyHistoryBack() teakey.Binding {
+	return k.HistoryBack
+}
+
+func (k *keyMap) GetKeyHistoryForward() teakey.Binding {
+	return k.HistoryForward
+}
+
+func (k *keyMap) GetKeyCycleTheme() teakey.Binding {
+	return k.CycleTheme
+}
+
+func (k *keyMap) GetKeyHelp() teakey.Binding {
+	return k.Help
+}
+
+func (k *keyMap) GetKeyNewTab() teakey.Binding {
+	return k.NewTab
+}
+
+func (k *keyMap) GetKeyCycleWorkspace() teakey.Binding {
+	return k.CycleWorkspace
+}
+
+func (k *keyMap) GetKeyToggleHeader() teakey.Binding {
+	return k.ToggleHeader
+}
+
+func (k *keyMap) GetKeyToggleWidgets() teakey.Binding {
+	return k.ToggleWidgets
+}
+
+func (k *keyMap) GetKeyNextTabPage() teakey.Binding {
+	return k.NextTabPage
+}
+
+func (k *keyMap) GetKeyPrevTabPage() teakey.Binding {
+	return k.PrevTabPage
+}
+
+func (k *keyMap) GetKeyCloseOtherPages() teakey.Binding {
+	return k.CloseOtherPages
+}
+
+func (k *keyMap) GetKeyClosePagesToTheRight() teakey.Binding {
+	return k.ClosePagesToTheRight
+}
+
+// GetKeyJumpToTab returns the key binding which activates the tab at the given index.
+func (k *keyMap) GetKeyJumpToTab(index int) teakey.Binding {
+	if index < 0 || index >= len(k.JumpToTab) {
+		return teakey.NewBinding()
+	}
+	return k.JumpToTab[index]
+}
+
+// ShortHelp returns the most important key bindings, it implements help.KeyMap.
+func (k *keyMap) ShortHelp() []teakey.Binding {
+	return []teakey.Binding{k.SwitchTabLeft, k.SwitchTabRight, k.Help, k.Quit}
+}
+
+// FullHelp returns all the key bindings grouped by their purpose, it implements help.KeyMap.
+func (k *keyMap) FullHelp() [][]teakey.Binding {
+	navigation := []teakey.Binding{k.SwitchTabLeft, k.SwitchTabRight, k.PrevTabPage, k.NextTabPage, k.HistoryBack, k.HistoryForward}
+	if len(k.JumpToTab) > 0 {
+		jump := k.JumpToTab[0]
+		jump.SetHelp(fmt.Sprintf(keymapJumpToTab, 1)+"…", "jump to tab")
+		navigation = append(navigation, jump)
+	}
+
+	return [][]teakey.Binding{
+		navigation,
+		{k.MovePageLeft, k.MovePageRight},
+		{k.NewTab, k.ClosePage, k.CloseOtherPages, k.ClosePagesToTheRight, k.ReopenPage, k.CycleWorkspace, k.CycleTheme},
+		{k.ToggleHeader, k.ToggleWidgets, k.Help, k.Quit},
+	}
+}
+
+// Names of the actions used to load key bindings from a user config.
+const (
+	ActionSwitchTabRight = "switch_tab_right"
+	ActionSwitchTabLeft  = "switch_tab_left"
+	ActionMovePageRight  = "move_page_right"
+	ActionMovePageLeft   = "move_page_left"
+	ActionQuit           = "quit"
+	ActionClosePage      = "close_page"
+	ActionReopenPage     = "reopen_page"
+	ActionHistoryBack    = "history_back"
+	ActionHistoryForward = "history_forward"
+	ActionCycleTheme     = "cycle_theme"
+	ActionHelp           = "help"
+	ActionNewTab         = "new_tab"
+	ActionCycleWorkspace = "cycle_workspace"
+	ActionToggleHeader   = "toggle_header"
+	ActionToggleWidgets  = "toggle_widgets"
+	ActionNextTabPage    = "next_tab_page"
+	ActionPrevTabPage    = "prev_tab_page"
+
+	ActionCloseOtherPages      = "close_other_pages"
+	ActionClosePagesToTheRight = "close_pages_to_the_right"
+
+	// ActionJumpToTab is formatted with the 1-based tab number, e.g. "jump_to_tab_1"
+	ActionJumpToTab = "jump_to_tab_%d"
+)
+
+// binding returns the key binding of the given action, or nil if there is no such action.
+func (k *keyMap) binding(action string) *teakey.Binding {
+	switch action {
+	case ActionSwitchTabRight:
+		return &k.SwitchTabRight
+	case ActionSwitchTabLeft:
+		return &k.SwitchTabLeft
+	case ActionMovePageRight:
+		return &k.MovePageRight
+	case ActionMovePageLeft:
+		return &k.MovePageLeft
+	case ActionQuit:
+		return &k.Quit
+	case ActionClosePage:
+		return &k.ClosePage
+	case ActionReopenPage:
+		return &k.ReopenPage
+	case ActionHistoryBack:
+		return &k.HistoryBack
+	case ActionHistoryForward:
+		return &k.HistoryForward
+	case ActionCycleTheme:
+		return &k.CycleTheme
+	case ActionHelp:
+		return &k.Help
+	case ActionNewTab:
+		return &k.NewTab
+	case ActionCycleWorkspace:
+		return &k.CycleWorkspace
+	case ActionToggleHeader:
+		return &k.ToggleHeader
+	case ActionToggleWidgets:
+		return &k.ToggleWidgets
+	case ActionNextTabPage:
+		return &k.NextTabPage
+	case ActionPrevTabPage:
+		return &k.PrevTabPage
+	case ActionCloseOtherPages:
+		return &k.CloseOtherPages
+	case ActionClosePagesToTheRight:
+		return &k.ClosePagesToTheRight
+	}
+
+	if index, ok := jumpToTabIndex(action); ok {
+		for len(k.JumpToTab) < index {
+			k.JumpToTab = append(k.JumpToTab, teakey.NewBinding())
+		}
+		return &k.JumpToTab[index-1]
+	}
+	return nil
+}
+
+// jumpToTabIndex returns the 1-based tab number of the given jump to tab action, false if it is not one.
+// The number has to be written plainly, e.g. "jump_to_tab_01" and "jump_to_tab_1x" are rejected, and it can
+// not be above the number of the default jump to tab bindings.
+func jumpToTabIndex(action string) (int, bool) {
+	number, ok := strings.CutPrefix(action, strings.TrimSuffix(ActionJumpToTab, "%d"))
+	if !ok {
+		return 0, false
+	}
+	index, err := strconv.Atoi(number)
+	if err != nil || strconv.Itoa(index) != number || index < 1 || index > jumpToTabCount {
+		return 0, false
+	}
+	return index, true
+}
+
+// setKeys replaces the keys of the given binding and keeps its help description.
+// Empty keys disable the binding.
+func setKeys(binding *teakey.Binding, keys []string) {
+	desc := binding.Help().Desc
+	if len(keys) == 0 {
+		*binding = teakey.NewBinding(teakey.WithHelp("", desc))
+		return
+	}
+	*binding = teakey.NewBinding(
+		teakey.WithKeys(keys...),
+		teakey.WithHelp(strings.Join(keys, "/"), desc),
+	)
+}
+
+// SetKeys sets the keys of the given action, see the Action constants. Empty keys disable the action.
+func (k *keyMap) SetKeys(action string, keys ...string) error {
+	binding := k.binding(action)
+	if binding == nil {
+		return fmt.Errorf("skeleton: unknown key binding action %q", action)
+	}
+	setKeys(binding, keys)
+	return nil
+}
+
+// LoadKeyBindings sets the keys of the actions from the given map of action names to keys.
+// The known actions are applied even if there is an unknown one, whose error is returned.
+func (k *keyMap) LoadKeyBindings(bindings map[string][]string) error {
+	var unknown []string
+	for action, keys := range bindings {
+		if err := k.SetKeys(action, keys...); err != nil {
+			unknown = append(unknown, action)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("skeleton: unknown key binding actions: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// actions returns the names of all actions, including the jump to tab ones.
+func (k *keyMap) actions() []string {
+	actions := []string{
+		ActionSwitchTabRight, ActionSwitchTabLeft, ActionMovePageRight, ActionMovePageLeft, ActionQuit, ActionClosePage, ActionReopenPage,
+		ActionHistoryBack, ActionHistoryForward, ActionCycleTheme, ActionHelp, ActionNewTab,
+		ActionCycleWorkspace, ActionToggleHeader, ActionToggleWidgets, ActionNextTabPage, ActionPrevTabPage,
+		ActionCloseOtherPages, ActionClosePagesToTheRight,
+	}
+	for i := range k.JumpToTab {
+		actions = append(actions, fmt.Sprintf(ActionJumpToTab, i+1))
+	}
+	return actions
+}
+
+// KeyBindings returns the keys of all actions, it is the inverse of LoadKeyBindings.
+func (k *keyMap) KeyBindings() map[string][]string {
+	actions := k.actions()
+	bindings := make(map[string][]string, len(actions))
+	for _, action := range actions {
+		bindings[action] = k.binding(action).Keys()
+	}
+	return bindings
+}
+
+// LoadKeyBindingsJSON reads a JSON object of action names to keys and applies it, e.g.
+//
+//	{"switch_tab_left": ["shift+left"], "switch_tab_right": ["shift+right"]}
+func (k *keyMap) LoadKeyBindingsJSON(r io.Reader) error {
+	var bindings map[string][]string
+	if err := json.NewDecoder(r).Decode(&bindings); err != nil {
+		return fmt.Errorf("skeleton: decode key bindings: %w", err)
+	}
+	return k.LoadKeyBindings(bindings)
+}
+
+// --------------------------------------------
+
+// SetQuitKeys sets the keys which quit the application.
+func (s *Skeleton) SetQuitKeys(keys ...string) *Skeleton {
+	setKeys(&s.KeyMap.Quit, keys)
+	return s
+}
+
+// SetSwitchTabKeys sets the keys which switch to the previous and the next tab.
+func (s *Skeleton) SetSwitchTabKeys(prev []string, next []string) *Skeleton {
+	setKeys(&s.KeyMap.SwitchTabLeft, prev)
+	setKeys(&s.KeyMap.SwitchTabRight, next)
+	return s
+}
+
+// SetKeys sets the keys of the given action, see the Action constants. Empty keys disable the action.
+func (s *Skeleton) SetKeys(action string, keys ...string) error {
+	return s.KeyMap.SetKeys(action, keys...)
+}
+
+// LoadKeyBindings sets the keys of the actions from a user config, e.g. for terminal
+// emulators which swallow the default ctrl+arrow keys.
+func (s *Skeleton) LoadKeyBindings(bindings map[string][]string) error {
+	return s.KeyMap.LoadKeyBindings(bindings)
+}
+
+// LoadKeyBindingsJSON reads the key bindings config as a JSON object of action names to keys.
+func (s *Skeleton) LoadKeyBindingsJSON(r io.Reader) error {
+	return s.KeyMap.LoadKeyBindingsJSON(r)
+}
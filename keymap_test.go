@@ -0,0 +1,88 @@
+package skeleton
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// keyPage records the keys it receives.
+type keyPage struct {
+	keys []string
+}
+
+func (p *keyPage) Init() tea.Cmd { return nil }
+
+func (p *keyPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		p.keys = append(p.keys, msg.String())
+	}
+	return p, nil
+}
+
+func (p *keyPage) View() string { return "key page" }
+
+func TestJumpToTabAction(t *testing.T) {
+	tests := []struct {
+		action string
+		index  int
+		ok     bool
+	}{
+		{action: "jump_to_tab_1", index: 1, ok: true},
+		{action: "jump_to_tab_9", index: 9, ok: true},
+		{action: "jump_to_tab_0"},
+		{action: "jump_to_tab_10"},
+		{action: "jump_to_tab_999999999"},
+		{action: "jump_to_tab_-1"},
+		{action: "jump_to_tab_+1"},
+		{action: "jump_to_tab_01"},
+		{action: "jump_to_tab_1x"},
+		{action: "jump_to_tab_1 "},
+		{action: "jump_to_tab_"},
+		{action: "jump_to_tab"},
+		{action: "switch_tab_right"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.action, func(t *testing.T) {
+			index, ok := jumpToTabIndex(tt.action)
+			if index != tt.index || ok != tt.ok {
+				t.Errorf("jumpToTabIndex(%q) = %d, %v, want %d, %v", tt.action, index, ok, tt.index, tt.ok)
+			}
+
+			km := newKeyMap()
+			err := km.SetKeys(tt.action, "f5")
+			if tt.ok {
+				if err != nil {
+					t.Fatalf("SetKeys(%q) failed: %v", tt.action, err)
+				}
+				if got := km.JumpToTab[tt.index-1].Keys(); !slices.Equal(got, []string{"f5"}) {
+					t.Errorf("tab %d keys are %v, want [f5]", tt.index, got)
+				}
+			} else if tt.action != "switch_tab_right" && err == nil {
+				t.Errorf("SetKeys(%q) succeeded", tt.action)
+			}
+			if len(km.JumpToTab) != jumpToTabCount {
+				t.Errorf("%d jump to tab bindings, want %d", len(km.JumpToTab), jumpToTabCount)
+			}
+		})
+	}
+}
+
+func TestDefaultKeysLeaveQuestionMarkToPage(t *testing.T) {
+	page := &keyPage{}
+	s := NewSkeleton()
+	s.AddPage("page", "Page", page)
+	updateSync(s, tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	updateSync(s, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}})
+	if !slices.Equal(page.keys, []string{"?"}) {
+		t.Errorf("page received %v, want [?]", page.keys)
+	}
+
+	updateSync(s, tea.KeyMsg{Type: tea.KeyF1})
+	if !s.helpVisible {
+		t.Error("f1 does not toggle the help")
+	}
+}
@@ -0,0 +1,144 @@
+package skeleton
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	teakey "github.com/charmbracelet/bubbles/key"
+)
+
+// KeyScope is the owner of an exported key binding.
+type KeyScope string
+
+const (
+	// KeyScopeSkeleton is the scope of the key bindings of the Skeleton, e.g. switching tabs.
+	KeyScopeSkeleton KeyScope = "skeleton"
+	// KeyScopeHotkey is the scope of the keys bound to pages, see BindKeyToPage.
+	KeyScopeHotkey KeyScope = "hotkey"
+	// KeyScopePage is the scope of the key bindings registered by the pages, see RegisterPageKeyMap.
+	KeyScopePage KeyScope = "page"
+)
+
+// KeyBindingInfo describes a key binding, e.g. to generate help screens, cheat sheets or shell completions.
+type KeyBindingInfo struct {
+	// Scope is the owner of the binding
+	Scope KeyScope `json:"scope"`
+
+	// Action is the name of the action of the Skeleton, it is empty for the other scopes
+	Action string `json:"action,omitempty"`
+
+	// Page is the key of the page the binding belongs to, it is empty for the Skeleton
+	Page string `json:"page,omitempty"`
+
+	// Keys are the keys which trigger the binding
+	Keys []string `json:"keys"`
+
+	// Description is the help text of the binding
+	Description string `json:"description,omitempty"`
+
+	// Enabled reports whether the binding is active
+	Enabled bool `json:"enabled"`
+}
+
+// ExportKeyBindings returns all current key bindings which have keys: the ones of the Skeleton,
+// the keys bound to pages and the key maps registered by the pages, in the order of the tabs.
+func (s *Skeleton) ExportKeyBindings() []KeyBindingInfo {
+	var bindings []KeyBindingInfo
+	for _, action := range s.KeyMap.actions() {
+		binding := s.KeyMap.binding(action)
+		if binding == nil || len(binding.Keys()) == 0 {
+			continue
+		}
+		bindings = append(bindings, keyBindingInfo(KeyScopeSkeleton, "", *binding))
+		bindings[len(bindings)-1].Action = action
+	}
+
+	for _, hotkey := range s.pageHotkeyHelp() {
+		info := keyBindingInfo(KeyScopeHotkey, s.pageHotkeys[hotkey.Keys()[0]], hotkey)
+		bindings = append(bindings, info)
+	}
+
+	for _, hdr := range s.header.headers {
+		keyMap, ok := s.pageKeyMaps[hdr.key]
+		if !ok {
+			continue
+		}
+		for _, group := range keyMap.FullHelp() {
+			for _, binding := range group {
+				if len(binding.Keys()) > 0 {
+					bindings = append(bindings, keyBindingInfo(KeyScopePage, hdr.key, binding))
+				}
+			}
+		}
+	}
+	return bindings
+}
+
+// KeyMapJSON returns all current key bindings as a JSON array, see ExportKeyBindings.
+func (s *Skeleton) KeyMapJSON() ([]byte, error) {
+	data, err := json.MarshalIndent(s.ExportKeyBindings(), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("skeleton: encode key bindings: %w", err)
+	}
+	return data, nil
+}
+
+// KeyMapMarkdown returns all current key bindings as Markdown tables, one for the Skeleton,
+// one for the keys bound to pages and one for every page which registered a key map.
+func (s *Skeleton) KeyMapMarkdown() string {
+	var b strings.Builder
+	section := ""
+	for _, info := range s.ExportKeyBindings() {
+		title := "Skeleton"
+		switch info.Scope {
+		case KeyScopeHotkey:
+			title = "Pages"
+		case KeyScopePage:
+			title = "Page: " + s.pageTitle(info.Page)
+		}
+
+		if title != section {
+			if section != "" {
+				b.WriteString("\n")
+			}
+			section = title
+			fmt.Fprintf(&b, "## %s\n\n| Keys | Description |\n| --- | --- |\n", title)
+		}
+
+		keys := make([]string, len(info.Keys))
+		for i, k := range info.Keys {
+			keys[i] = "`" + markdownCell(k) + "`"
+		}
+		description := info.Description
+		if !info.Enabled {
+			description += " (disabled)"
+		}
+		fmt.Fprintf(&b, "| %s | %s |\n", strings.Join(keys, ", "), markdownCell(description))
+	}
+	return b.String()
+}
+
+// keyBindingInfo returns the description of the given binding.
+func keyBindingInfo(scope KeyScope, page string, binding teakey.Binding) KeyBindingInfo {
+	return KeyBindingInfo{
+		Scope:       scope,
+		Page:        page,
+		Keys:        binding.Keys(),
+		Description: binding.Help().Desc,
+		Enabled:     binding.Enabled(),
+	}
+}
+
+// markdownCell escapes the pipes which would end a cell of a Markdown table.
+func markdownCell(text string) string {
+	return strings.ReplaceAll(text, "|", `\|`)
+}
+
+// pageTitle returns the title of the page by the given key, or the key if there is no such page.
+func (s *Skeleton) pageTitle(key string) string {
+	if index := s.pageIndex(key); index >= 0 {
+		return s.header.headers[index].title
+	}
+	return key
+}
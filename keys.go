@@ -0,0 +1,39 @@
+package skeleton
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+// ErrInvalidKey is returned when a key contains control characters or is empty while key normalization is enabled.
+var ErrInvalidKey = errors.New("skeleton: invalid key")
+
+// SetKeyNormalization enables or disables the normalization of the page and widget keys. When it is enabled,
+// keys are trimmed and lowercased on every call, and keys with control characters are rejected. It should be
+// set before pages and widgets are added.
+func (s *Skeleton) SetKeyNormalization(enabled bool) *Skeleton {
+	s.properties.normalizeKeys = enabled
+	return s
+}
+
+// IsKeyNormalization returns the normalization of the page and widget keys is enabled or not.
+func (s *Skeleton) IsKeyNormalization() bool {
+	return s.properties.normalizeKeys
+}
+
+// normalizeKey returns the given key normalized if key normalization is enabled.
+func (s *Skeleton) normalizeKey(key string) string {
+	if !s.properties.normalizeKeys {
+		return key
+	}
+	return strings.ToLower(strings.TrimSpace(key))
+}
+
+// validKey reports whether the given normalized key can be used, keys are only checked if key normalization is enabled.
+func (s *Skeleton) validKey(key string) bool {
+	if !s.properties.normalizeKeys {
+		return true
+	}
+	return key != "" && strings.IndexFunc(key, unicode.IsControl) < 0
+}
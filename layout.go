@@ -0,0 +1,238 @@
+package skeleton
+
+import (
+	"cmp"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Layout is a serializable description of the Skeleton shell: regions, their sizes and styles.
+// It can be exported with ExportLayout and loaded at runtime with ApplyLayout. The settings are pointers or
+// strings, so a partial layout, e.g. one written by hand, leaves the settings it doesn't mention unchanged.
+type Layout struct {
+	BorderColor string         `json:"borderColor,omitempty"`
+	WrapTabs    *bool          `json:"wrapTabs,omitempty"`
+	Header      HeaderLayout   `json:"header"`
+	Body        BodyLayout     `json:"body"`
+	Footer      FooterLayout   `json:"footer"`
+	Tabs        []TabLayout    `json:"tabs,omitempty"`
+	Widgets     []WidgetLayout `json:"widgets,omitempty"`
+}
+
+// HeaderLayout describes the header region.
+type HeaderLayout struct {
+	// Height is the rendered height of the header, it is ignored by ApplyLayout
+	Height int `json:"height,omitempty"`
+
+	LeftPadding            *int   `json:"leftPadding,omitempty"`
+	RightPadding           *int   `json:"rightPadding,omitempty"`
+	TabMaxWidth            *int   `json:"tabMaxWidth,omitempty"`
+	Bottom                 *bool  `json:"bottom,omitempty"`
+	Sidebar                *bool  `json:"sidebar,omitempty"`
+	SidebarWidth           *int   `json:"sidebarWidth,omitempty"`
+	ActiveTabTextColor     string `json:"activeTabTextColor,omitempty"`
+	ActiveTabBorderColor   string `json:"activeTabBorderColor,omitempty"`
+	InactiveTabTextColor   string `json:"inactiveTabTextColor,omitempty"`
+	InactiveTabBorderColor string `json:"inactiveTabBorderColor,omitempty"`
+}
+
+// BodyLayout describes the body region where the pages are rendered.
+type BodyLayout struct {
+	// Width and Height are the rendered size of the body, they are ignored by ApplyLayout
+	Width  int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+
+	// Position is the horizontal alignment of the page, 0 is left, 0.5 is center and 1 is right
+	Position *float64 `json:"position,omitempty"`
+}
+
+// FooterLayout describes the footer (widget) region.
+type FooterLayout struct {
+	// Height is the rendered height of the footer, it is ignored by ApplyLayout
+	Height int `json:"height,omitempty"`
+
+	LeftPadding       *int   `json:"leftPadding,omitempty"`
+	RightPadding      *int   `json:"rightPadding,omitempty"`
+	WidgetBorderColor string `json:"widgetBorderColor,omitempty"`
+}
+
+// TabLayout describes a tab. Pages can not be created from a layout, so ApplyLayout
+// only updates the title, the lock state and the colors of the existing pages. Empty titles are left unchanged.
+type TabLayout struct {
+	Key    string `json:"key"`
+	Title  string `json:"title,omitempty"`
+	Locked *bool  `json:"locked,omitempty"`
+
+	// ActiveColor and InactiveColor are the border colors of the tab, empty uses the header colors
+	ActiveColor   string `json:"activeColor,omitempty"`
+	InactiveColor string `json:"inactiveColor,omitempty"`
+}
+
+// WidgetLayout describes a widget. ApplyLayout only updates the value of the existing widgets, empty values
+// are left unchanged.
+type WidgetLayout struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+// ExportLayout returns the current layout of the Skeleton.
+func (s *Skeleton) ExportLayout() Layout {
+	hp := s.header.properties
+	wp := s.widget.properties
+
+	layout := Layout{
+		BorderColor: s.properties.borderColor,
+		WrapTabs:    valuePtr(s.properties.wrapTabs),
+		Header: HeaderLayout{
+			LeftPadding:            valuePtr(hp.leftTabPadding),
+			RightPadding:           valuePtr(hp.rightTabPadding),
+			TabMaxWidth:            valuePtr(hp.tabMaxWidth),
+			Bottom:                 valuePtr(s.isHeaderAtBottom()),
+			Sidebar:                valuePtr(hp.tabLayout == TabsSidebar),
+			SidebarWidth:           valuePtr(hp.sidebarWidth),
+			ActiveTabTextColor:     colorString(hp.titleStyleActive.GetForeground()),
+			ActiveTabBorderColor:   colorString(hp.titleStyleActive.GetBorderTopForeground()),
+			InactiveTabTextColor:   colorString(hp.titleStyleInactive.GetForeground()),
+			InactiveTabBorderColor: colorString(hp.titleStyleInactive.GetBorderTopForeground()),
+		},
+		Body: BodyLayout{
+			Width:    s.GetContentWidth(),
+			Position: valuePtr(float64(s.properties.pagePosition)),
+		},
+		Footer: FooterLayout{
+			LeftPadding:       valuePtr(wp.leftTabPadding),
+			RightPadding:      valuePtr(wp.rightTabPadding),
+			WidgetBorderColor: colorString(wp.widgetStyle.GetBorderTopForeground()),
+		},
+	}
+
+	if s.termReady {
+		layout.Header.Height = lipgloss.Height(s.header.View())
+		layout.Footer.Height = lipgloss.Height(s.widget.View())
+		layout.Body.Height = s.GetContentHeight()
+	}
+
+	for _, hdr := range s.header.headers {
+		activeColor, inactiveColor := s.GetTabColor(hdr.key)
+		layout.Tabs = append(layout.Tabs, TabLayout{
+			Key:           hdr.key,
+			Title:         hdr.title,
+			Locked:        valuePtr(s.IsTabLocked(hdr.key)),
+			ActiveColor:   activeColor,
+			InactiveColor: inactiveColor,
+		})
+	}
+
+	for _, wgt := range s.widget.shownWidgets() {
+		layout.Widgets = append(layout.Widgets, WidgetLayout{
+			Key:   wgt.Key,
+			Value: wgt.Value,
+		})
+	}
+
+	return layout
+}
+
+// ApplyLayout applies the given layout to the Skeleton. Only the settings the layout has are applied, nil
+// settings, empty colors and empty titles are left unchanged.
+func (s *Skeleton) ApplyLayout(layout Layout) *Skeleton {
+	if layout.BorderColor != "" {
+		s.SetBorderColor(layout.BorderColor)
+	}
+	if layout.WrapTabs != nil {
+		s.SetWrapTabs(*layout.WrapTabs)
+	}
+	if layout.Body.Position != nil {
+		s.SetPagePosition(lipgloss.Position(*layout.Body.Position))
+	}
+
+	if layout.Header.LeftPadding != nil {
+		s.SetTabLeftPadding(*layout.Header.LeftPadding)
+	}
+	if layout.Header.RightPadding != nil {
+		s.SetTabRightPadding(*layout.Header.RightPadding)
+	}
+	if layout.Header.TabMaxWidth != nil {
+		s.SetTabMaxWidth(*layout.Header.TabMaxWidth)
+	}
+	if layout.Header.Bottom != nil {
+		if *layout.Header.Bottom {
+			s.SetHeaderPosition(HeaderBottom)
+		} else {
+			s.SetHeaderPosition(HeaderTop)
+		}
+	}
+	if layout.Header.SidebarWidth != nil && *layout.Header.SidebarWidth > 0 {
+		s.SetSidebarWidth(*layout.Header.SidebarWidth)
+	}
+	if layout.Header.Sidebar != nil {
+		if *layout.Header.Sidebar {
+			s.SetTabLayout(TabsSidebar)
+		} else {
+			s.SetTabLayout(TabsHorizontal)
+		}
+	}
+	if layout.Header.ActiveTabTextColor != "" {
+		s.SetActiveTabTextColor(layout.Header.ActiveTabTextColor)
+	}
+	if layout.Header.ActiveTabBorderColor != "" {
+		s.SetActiveTabBorderColor(layout.Header.ActiveTabBorderColor)
+	}
+	if layout.Header.InactiveTabTextColor != "" {
+		s.SetInactiveTabTextColor(layout.Header.InactiveTabTextColor)
+	}
+	if layout.Header.InactiveTabBorderColor != "" {
+		s.SetInactiveTabBorderColor(layout.Header.InactiveTabBorderColor)
+	}
+
+	if layout.Footer.LeftPadding != nil {
+		s.SetWidgetLeftPadding(*layout.Footer.LeftPadding)
+	}
+	if layout.Footer.RightPadding != nil {
+		s.SetWidgetRightPadding(*layout.Footer.RightPadding)
+	}
+	if layout.Footer.WidgetBorderColor != "" {
+		s.SetWidgetBorderColor(layout.Footer.WidgetBorderColor)
+	}
+
+	for _, tab := range layout.Tabs {
+		if !s.hasPage(tab.Key) {
+			continue
+		}
+		if tab.Title != "" {
+			s.UpdatePageTitle(tab.Key, tab.Title)
+		}
+		if tab.Locked != nil {
+			if *tab.Locked {
+				s.LockTab(tab.Key)
+			} else {
+				s.UnlockTab(tab.Key)
+			}
+		}
+		if tab.ActiveColor != "" || tab.InactiveColor != "" {
+			activeColor, inactiveColor := s.GetTabColor(tab.Key)
+			s.SetTabColor(tab.Key, cmp.Or(tab.ActiveColor, activeColor), cmp.Or(tab.InactiveColor, inactiveColor))
+		}
+	}
+
+	for _, wgt := range layout.Widgets {
+		if wgt.Value != "" {
+			s.UpdateWidgetValue(wgt.Key, wgt.Value)
+		}
+	}
+
+	return s
+}
+
+// valuePtr returns a pointer to a copy of the given value, it is used to export the layout settings.
+func valuePtr[T any](v T) *T {
+	return &v
+}
+
+// colorString returns the string form of the given color, or empty string if it is not set.
+func colorString(color lipgloss.TerminalColor) string {
+	if c, ok := color.(lipgloss.Color); ok {
+		return string(c)
+	}
+	return ""
+}
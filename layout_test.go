@@ -0,0 +1,73 @@
+package skeleton
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestApplyPartialLayout(t *testing.T) {
+	s := NewSkeleton()
+	s.AddPage("first", "First", newTestPage())
+	s.AddPage("second", "Second", newTestPage())
+	s.AddWidget("w", "value")
+	s.SetTabLeftPadding(3).SetTabRightPadding(4).SetTabMaxWidth(12).SetWrapTabs(true)
+	s.SetPagePosition(lipgloss.Center).SetHeaderPosition(HeaderBottom).SetTabLayout(TabsSidebar)
+	s.SetWidgetLeftPadding(2).SetWidgetRightPadding(5)
+	s.SetTabColor("first", "99", "")
+	s.LockTab("first")
+	before := s.ExportLayout()
+
+	var layout Layout
+	data := `{"header":{"tabMaxWidth":20},"tabs":[{"key":"first","activeColor":"42"},{"key":"second","title":"Renamed"}],"widgets":[{"key":"w"}]}`
+	if err := json.Unmarshal([]byte(data), &layout); err != nil {
+		t.Fatal(err)
+	}
+	s.ApplyLayout(layout)
+	after := s.ExportLayout()
+
+	if got := *after.Header.TabMaxWidth; got != 20 {
+		t.Errorf("tab max width is %d, want 20", got)
+	}
+	if got := after.Tabs[0].ActiveColor; got != "42" {
+		t.Errorf("active color of the first tab is %q, want %q", got, "42")
+	}
+	if got := after.Tabs[1].Title; got != "Renamed" {
+		t.Errorf("title of the second tab is %q, want %q", got, "Renamed")
+	}
+
+	// the settings the layout doesn't have are kept
+	after.Header.TabMaxWidth = before.Header.TabMaxWidth
+	after.Tabs[0].ActiveColor = before.Tabs[0].ActiveColor
+	after.Tabs[1].Title = before.Tabs[1].Title
+	if !reflect.DeepEqual(after, before) {
+		t.Errorf("partial layout changed other settings:\nbefore %+v\nafter  %+v", before, after)
+	}
+}
+
+func TestExportedLayoutRoundTrip(t *testing.T) {
+	source := NewSkeleton()
+	source.AddPage("page", "Page", newTestPage())
+	source.SetTabLeftPadding(0).SetWrapTabs(true).SetPagePosition(lipgloss.Right).SetHeaderPosition(HeaderBottom)
+	source.LockTab("page")
+
+	data, err := json.Marshal(source.ExportLayout())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var layout Layout
+	if err := json.Unmarshal(data, &layout); err != nil {
+		t.Fatal(err)
+	}
+
+	target := NewSkeleton()
+	target.AddPage("page", "Other title", newTestPage())
+	target.SetTabLeftPadding(6)
+	target.ApplyLayout(layout)
+
+	if got, want := target.ExportLayout(), source.ExportLayout(); !reflect.DeepEqual(got, want) {
+		t.Errorf("applied layout differs:\ngot  %+v\nwant %+v", got, want)
+	}
+}
@@ -0,0 +1,46 @@
+package skeleton
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// messageLogLimit is the number of recent messages kept for the support bundle.
+const messageLogLimit = 200
+
+// MessageLogEntry is a message handled by the Skeleton. Only the type of the message is kept,
+// so typed text or passwords never end up in a support bundle.
+type MessageLogEntry struct {
+	Time time.Time `json:"time"`
+	Type string    `json:"type"`
+}
+
+// messageLog is hold the recent messages handled by the Skeleton.
+type messageLog struct {
+	mu      sync.Mutex
+	entries []MessageLogEntry
+}
+
+// record adds the given message handled at the given time to the log, the oldest entries are dropped.
+func (l *messageLog) record(msg any, at time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = append(l.entries, MessageLogEntry{
+		Time: at,
+		Type: fmt.Sprintf("%T", msg),
+	})
+	if len(l.entries) > messageLogLimit {
+		l.entries = l.entries[len(l.entries)-messageLogLimit:]
+	}
+}
+
+// GetMessageLog returns the recent messages handled by the Skeleton, oldest first.
+func (s *Skeleton) GetMessageLog() []MessageLogEntry {
+	s.messageLog.mu.Lock()
+	defer s.messageLog.mu.Unlock()
+	out := make([]MessageLogEntry, len(s.messageLog.entries))
+	copy(out, s.messageLog.entries)
+	return out
+}
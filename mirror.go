@@ -0,0 +1,87 @@
+package skeleton
+
+import (
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// AttachMirror mirrors the composed frames of the Skeleton to the given writer, e.g. the session of an
+// SSH viewer, for pair-debugging and shared dashboards. Mirrors are read-only, only the program of the
+// Skeleton reads the input. A slow mirror skips frames instead of blocking the rendering, and it is
+// detached when writing to it fails. The returned function detaches the mirror.
+func (s *Skeleton) AttachMirror(w io.Writer) (detach func()) {
+	if w == nil {
+		return func() {}
+	}
+
+	latest := make(chan Frame, 1)
+	done := make(chan struct{})
+	cancel := s.OnFrame(func(frame Frame) {
+		// keep the latest frame only, the mirror renders the whole screen anyway
+		select {
+		case <-latest:
+		default:
+		}
+		select {
+		case latest <- frame:
+		default:
+		}
+	})
+
+	var once sync.Once
+	detach = func() {
+		once.Do(func() {
+			cancel()
+			close(done)
+			s.mirrorCount.Add(-1)
+		})
+	}
+	s.mirrorCount.Add(1)
+
+	go func() {
+		defer s.restoreOnPanic()
+		if _, err := io.WriteString(w, ansi.HideCursor); err != nil {
+			detach()
+			return
+		}
+		for {
+			select {
+			case <-done:
+				return
+			case frame := <-latest:
+				if _, err := io.WriteString(w, mirrorFrame(frame.View)); err != nil {
+					detach()
+					return
+				}
+			}
+		}
+	}()
+
+	// render a frame for the new mirror
+	s.updater.Update()
+	return detach
+}
+
+// GetMirrorCount returns the number of attached mirrors.
+func (s *Skeleton) GetMirrorCount() int {
+	return int(s.mirrorCount.Load())
+}
+
+// mirrorFrame returns the sequence which redraws the whole screen of a mirror with the given view.
+// The lines are overwritten in place rather than clearing the screen, so the mirror does not flicker.
+func mirrorFrame(view string) string {
+	var b strings.Builder
+	b.WriteString(ansi.CursorHomePosition)
+	for i, line := range strings.Split(view, "\n") {
+		if i > 0 {
+			b.WriteString("\r\n")
+		}
+		b.WriteString(line)
+		b.WriteString(ansi.EraseLineRight)
+	}
+	b.WriteString(ansi.EraseScreenBelow)
+	return b.String()
+}
@@ -0,0 +1,205 @@
+package skeleton
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ModalKind is the kind of a modal dialog.
+type ModalKind int
+
+const (
+	// ModalAlert shows a message which can only be dismissed.
+	ModalAlert ModalKind = iota
+	// ModalConfirm asks the user a yes/no question.
+	ModalConfirm
+	// ModalPrompt asks the user to enter a text.
+	ModalPrompt
+)
+
+var (
+	modalConfirmKey = key.NewBinding(key.WithKeys("y", "Y", "enter"))
+	modalCancelKey  = key.NewBinding(key.WithKeys("n", "N", "esc"))
+	modalSubmitKey  = key.NewBinding(key.WithKeys("enter"))
+	modalDismissKey = key.NewBinding(key.WithKeys("esc"))
+	modalDeleteKey  = key.NewBinding(key.WithKeys("backspace"))
+)
+
+// ModalResultMsg is sent to the pages when a modal dialog is closed.
+type ModalResultMsg struct {
+	// Kind is the kind of the closed modal
+	Kind ModalKind
+
+	// Title is the title of the closed modal, it can be used to identify it
+	Title string
+
+	// Confirmed reports the user accepted the modal (yes / enter)
+	Confirmed bool
+
+	// Value is the entered text of a prompt
+	Value string
+}
+
+// modal is hold a single modal dialog.
+type modal struct {
+	kind        ModalKind
+	title       string
+	message     string
+	placeholder string
+	value       []rune
+	onResult    func(ModalResultMsg)
+}
+
+// ShowAlert shows a modal dialog with the given message, it is dismissed with enter or esc.
+func (s *Skeleton) ShowAlert(title string, message string) *Skeleton {
+	s.pushModal(&modal{
+		kind:    ModalAlert,
+		title:   title,
+		message: message,
+	})
+	return s
+}
+
+// ShowConfirm shows a yes/no modal dialog. onResult is called with the answer of the user, it may be nil.
+func (s *Skeleton) ShowConfirm(title string, message string, onResult func(confirmed bool)) *Skeleton {
+	s.pushModal(&modal{
+		kind:    ModalConfirm,
+		title:   title,
+		message: message,
+		onResult: func(result ModalResultMsg) {
+			if onResult != nil {
+				onResult(result.Confirmed)
+			}
+		},
+	})
+	return s
+}
+
+// ShowPrompt shows a modal dialog which asks the user to enter a text. onResult is called with
+// the entered text and false if the user cancelled the prompt, it may be nil.
+func (s *Skeleton) ShowPrompt(title string, message string, placeholder string, onResult func(value string, ok bool)) *Skeleton {
+	s.pushModal(&modal{
+		kind:        ModalPrompt,
+		title:       title,
+		message:     message,
+		placeholder: placeholder,
+		onResult: func(result ModalResultMsg) {
+			if onResult != nil {
+				onResult(result.Value, result.Confirmed)
+			}
+		},
+	})
+	return s
+}
+
+// IsModalOpen returns true if a modal dialog is shown.
+func (s *Skeleton) IsModalOpen() bool {
+	return len(s.modals) > 0
+}
+
+// CloseModal closes the shown modal dialog as cancelled.
+func (s *Skeleton) CloseModal() *Skeleton {
+	if s.IsModalOpen() {
+		s.closeModal(false)
+	}
+	return s
+}
+
+// pushModal queues the given modal, it is shown after the modals before it are closed.
+func (s *Skeleton) pushModal(m *modal) {
+	s.modals = append(s.modals, m)
+	s.updater.Update()
+}
+
+// closeModal closes the shown modal and reports its result.
+func (s *Skeleton) closeModal(confirmed bool) {
+	m := s.modals[0]
+	s.modals = s.modals[1:]
+
+	result := ModalResultMsg{
+		Kind:      m.kind,
+		Title:     m.title,
+		Confirmed: confirmed,
+	}
+	if m.kind == ModalPrompt && confirmed {
+		result.Value = string(m.value)
+	}
+
+	if m.onResult != nil {
+		m.onResult(result)
+	}
+	s.updater.UpdateWithMsg(result)
+}
+
+// handleModalKey handles the key press while a modal is shown, the pages do not receive it.
+func (s *Skeleton) handleModalKey(msg tea.KeyMsg) {
+	m := s.modals[0]
+
+	switch m.kind {
+	case ModalAlert:
+		if key.Matches(msg, modalSubmitKey, modalDismissKey) {
+			s.closeModal(true)
+		}
+	case ModalConfirm:
+		switch {
+		case key.Matches(msg, modalConfirmKey):
+			s.closeModal(true)
+		case key.Matches(msg, modalCancelKey):
+			s.closeModal(false)
+		}
+	case ModalPrompt:
+		switch {
+		case key.Matches(msg, modalSubmitKey):
+			s.closeModal(true)
+		case key.Matches(msg, modalDismissKey):
+			s.closeModal(false)
+		case key.Matches(msg, modalDeleteKey):
+			if len(m.value) > 0 {
+				m.value = m.value[:len(m.value)-1]
+			}
+		case msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace:
+			m.value = append(m.value, msg.Runes...)
+		}
+	}
+}
+
+// modalView renders the shown modal dialog.
+func (s *Skeleton) modalView(maxWidth int) string {
+	m := s.modals[0]
+
+	var hint string
+	switch m.kind {
+	case ModalAlert:
+		hint = "enter: ok"
+	case ModalConfirm:
+		hint = "y: yes • n: no"
+	case ModalPrompt:
+		hint = "enter: submit • esc: cancel"
+	}
+
+	var lines []string
+	if m.title != "" {
+		lines = append(lines, lipgloss.NewStyle().Bold(true).Render(m.title), "")
+	}
+	if m.message != "" {
+		lines = append(lines, m.message)
+	}
+	if m.kind == ModalPrompt {
+		input := string(m.value) + "█"
+		if len(m.value) == 0 && m.placeholder != "" {
+			input = "█" + lipgloss.NewStyle().Faint(true).Render(m.placeholder)
+		}
+		lines = append(lines, "", "> "+input)
+	}
+	lines = append(lines, "", lipgloss.NewStyle().Faint(true).Render(hint))
+
+	return lipgloss.NewStyle().
+		Border(s.properties.glyphs.Frame).
+		BorderForeground(lipgloss.Color(s.properties.borderColor)).
+		Padding(0, 2).
+		MaxWidth(maxWidth).
+		Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,313 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	// hitBoxNone is used for rendered parts of the header which can not be clicked.
+	hitBoxNone = -2
+
+	// hitBoxNewTab is the index of the "+" element of the header.
+	hitBoxNewTab = -1
+)
+
+// closeGlyph is rendered at the end of the closable tab titles.
+const closeGlyph = "✕"
+
+// headerHitBox is hold the horizontal range of a rendered tab, end is exclusive.
+type headerHitBox struct {
+	index int
+	start int
+	end   int
+}
+
+// widgetHitBox is hold the horizontal range of a rendered widget, end is exclusive.
+type widgetHitBox struct {
+	key   string
+	row   int
+	start int
+	end   int
+}
+
+// WidgetClickHandler is called when a widget is clicked.
+type WidgetClickHandler func() tea.Cmd
+
+// mouseModeMsg enables or disables the mouse events while the program is running.
+type mouseModeMsg struct {
+	enabled bool
+}
+
+// hitTest returns the hit box at the given column of the header. The boxes of the tabs deleted since
+// the last render are skipped.
+func (h *header) hitTest(x int) (headerHitBox, bool) {
+	for _, box := range h.hitBoxes {
+		if box.index >= len(h.headers) {
+			continue
+		}
+		if x >= box.start && x < box.end {
+			return box, true
+		}
+	}
+	return headerHitBox{}, false
+}
+
+// isOnCloseGlyph returns true if the given column is on the close glyph of the tab in the hit box.
+func (h *header) isOnCloseGlyph(box headerHitBox, x int) bool {
+	if box.index < 0 || h.compact || !h.showsCloseGlyph(h.headers[box.index].key) {
+		return false
+	}
+	// the glyph is the last cell before the right padding and the border
+	return x == box.end-2-h.properties.rightTabPadding
+}
+
+// SetMouseEnabled enables or disables the mouse support. Mouse events which are not handled by the Skeleton
+// are forwarded to the active page, with coordinates relative to the page content.
+func (s *Skeleton) SetMouseEnabled(enabled bool) *Skeleton {
+	s.properties.mouseEnabled = enabled
+	s.updater.UpdateWithMsg(mouseModeMsg{enabled: enabled})
+	return s
+}
+
+// IsMouseEnabled returns the mouse support is enabled or not.
+func (s *Skeleton) IsMouseEnabled() bool {
+	return s.properties.mouseEnabled
+}
+
+// SetTabClosable renders a close glyph on the tab by the given key. Clicking it closes the tab
+// the same way as the close page key binding, including the dirty page confirmation and the close handler.
+// The glyph is not rendered while the tab is locked or pinned.
+func (s *Skeleton) SetTabClosable(key string, closable bool) *Skeleton {
+	key = s.normalizeKey(key)
+	if closable {
+		s.header.closableTabs[key] = true
+	} else {
+		delete(s.header.closableTabs, key)
+	}
+	s.header.calculateTitleLength()
+	s.updater.Update()
+	return s
+}
+
+// CloseRequestHandler is called before a tab is closed by its close glyph or a tab action.
+// It returns true to close the tab, or false to keep it.
+type CloseRequestHandler func(key string) bool
+
+// SetOnCloseRequested sets the handler which can veto closing a tab by its close glyph. Nil removes it.
+func (s *Skeleton) SetOnCloseRequested(handler CloseRequestHandler) *Skeleton {
+	s.onCloseRequested = handler
+	return s
+}
+
+// canCloseTab returns true if the tab by the given key is neither locked nor pinned.
+func (h *header) canCloseTab(key string) bool {
+	return !h.IsTabLocked(key) && !h.GetLockTabs() && h.stickyTabs[key] == StickyNone
+}
+
+// showsCloseGlyph returns true if the close glyph is rendered on the tab by the given key.
+func (h *header) showsCloseGlyph(key string) bool {
+	return h.closableTabs[key] && h.canCloseTab(key)
+}
+
+// IsTabClosable returns the tab by the given key renders a close glyph or not.
+func (s *Skeleton) IsTabClosable(key string) bool {
+	key = s.normalizeKey(key)
+	return s.header.closableTabs[key]
+}
+
+// closePage closes the page by the given key. Locked and pinned tabs are not closed, the close handler
+// can veto it and dirty pages are confirmed first.
+func (s *Skeleton) closePage(key string) {
+	if !s.header.canCloseTab(key) {
+		return
+	}
+	if s.onCloseRequested != nil && !s.onCloseRequested(key) {
+		return
+	}
+	if s.IsPageDirty(key) {
+		s.requestClose(key)
+		return
+	}
+	s.DeletePage(key)
+}
+
+// hitTest returns the key of the widget at the given column of the given row of the footer or the top bar.
+func (w *widget) hitTest(x int, row int, top bool) (string, bool) {
+	boxes := w.hitBoxes
+	if top {
+		boxes = w.topHitBoxes
+	}
+	for _, box := range boxes {
+		if box.row == row && x >= box.start && x < box.end {
+			return box.key, true
+		}
+	}
+	return "", false
+}
+
+// OnWidgetClick sets the handler which is called when the widget by the given key is clicked. Nil removes it.
+// Mouse support has to be enabled with SetMouseEnabled.
+func (s *Skeleton) OnWidgetClick(key string, handler WidgetClickHandler) *Skeleton {
+	key = s.normalizeKey(key)
+	if handler == nil {
+		delete(s.widget.clickHandlers, key)
+	} else {
+		s.widget.clickHandlers[key] = handler
+	}
+	return s
+}
+
+// headerHeight returns the rendered height of the header.
+func (s *Skeleton) headerHeight() int {
+	return lipgloss.Height(s.header.View())
+}
+
+// footerHeight returns the rendered height of the footer.
+func (s *Skeleton) footerHeight() int {
+	return lipgloss.Height(s.widget.View())
+}
+
+// bodyTop returns the row which the body starts at.
+func (s *Skeleton) bodyTop() int {
+	if s.isHeaderAtBottom() {
+		return s.footerHeight() + s.widget.statusRowHeight()
+	}
+	return s.headerHeight() + s.subtitleHeight() + s.topBarHeight()
+}
+
+// edgesAt returns the given row is in the footer or in the header.
+func (s *Skeleton) edgesAt(y int) (inFooter bool, inHeader bool) {
+	if s.isHeaderAtBottom() {
+		return y < s.footerHeight(), y >= s.viewport.Height-s.headerHeight()
+	}
+	return y >= s.viewport.Height-s.footerHeight(), y < s.headerHeight()
+}
+
+// mouseMode returns the command which enables the mouse events, all motion is reported when the focus follows the mouse.
+func (s *Skeleton) mouseMode() tea.Cmd {
+	if s.properties.focusFollowsMouse {
+		return tea.EnableMouseAllMotion
+	}
+	return tea.EnableMouseCellMotion
+}
+
+// GetContentOffset returns the terminal cell which the top left corner of the page content is rendered at.
+func (s *Skeleton) GetContentOffset() (x int, y int) {
+	x = 1 // for the left border
+	if s.header.isSidebar() {
+		x += s.header.sidebarWidth() + 1 // for the sidebar and its separator
+	}
+	return x, s.bodyTop()
+}
+
+// localMouseMsg translates the coordinates of the mouse event to be relative to the page content,
+// so pages can hit-test their own elements. Events outside of the content have negative or
+// out of range coordinates.
+func (s *Skeleton) localMouseMsg(msg tea.MouseMsg) tea.MouseMsg {
+	x, y := s.GetContentOffset()
+	msg.X -= x
+	msg.Y -= y
+	return msg
+}
+
+// handleMouse handles the mouse events of the header and the footer. It returns true if the event is consumed.
+func (s *Skeleton) handleMouse(msg tea.MouseMsg) (tea.Cmd, bool) {
+	if s.IsModalOpen() {
+		return nil, true
+	}
+
+	if row, ok := s.topBarRowAt(msg.Y); ok {
+		return s.handleWidgetMouse(msg, row, true)
+	}
+	inFooter, inHeader := s.edgesAt(msg.Y)
+	if inFooter {
+		return s.handleWidgetMouse(msg, s.footerRowAt(msg.Y), false)
+	}
+
+	if !inHeader && s.header.isSidebar() && msg.X >= 1 && msg.X <= s.header.sidebarWidth() {
+		return s.handleSidebarMouse(msg)
+	}
+
+	box, hit := s.header.hitTest(msg.X)
+	onClose := inHeader && hit && s.header.isOnCloseGlyph(box, msg.X)
+
+	switch msg.Action {
+	case tea.MouseActionMotion:
+		s.header.hoveredClose = -1
+		s.header.hoveredTab = -1
+		if onClose {
+			s.header.hoveredClose = box.index
+		}
+		if inHeader && hit {
+			s.header.hoveredTab = box.index
+		}
+	case tea.MouseActionPress:
+		if !inHeader {
+			break
+		}
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			return tea.Batch(s.switchPage(nil, "left")...), true
+		case tea.MouseButtonWheelDown:
+			return tea.Batch(s.switchPage(nil, "right")...), true
+		case tea.MouseButtonLeft:
+			if !hit {
+				break
+			}
+			switch {
+			case onClose:
+				s.closePage(s.header.headers[box.index].key)
+				return nil, true
+			case box.index == hitBoxNewTab:
+				return s.NewTab(), true
+			case box.index >= 0:
+				s.showBlockedReason(box.index)
+				var cmds []tea.Cmd
+				if s.JumpToTab(box.index) {
+					cmds = append(cmds, s.IAMActivePageCmd())
+				}
+				if !s.permissions.ReadOnly {
+					cmds = append(cmds, s.registerTabClick(box.index))
+				}
+				return tea.Batch(cmds...), true
+			}
+		}
+	}
+
+	return nil, inHeader
+}
+
+// footerRowAt returns the footer row at the given row of the terminal, the rows are mirrored
+// when the footer is rendered at the top.
+func (s *Skeleton) footerRowAt(y int) int {
+	height := s.footerHeight()
+	line := y - (s.viewport.Height - height)
+	if s.isHeaderAtBottom() {
+		// the footer is flipped at the top, its last line is the first line of the terminal
+		line = height - 1 - y
+	}
+	return max(line, 0) / footerRowHeight
+}
+
+// handleWidgetMouse handles the mouse events of the given row of the footer or the top bar, clicks on
+// widgets call their handlers.
+func (s *Skeleton) handleWidgetMouse(msg tea.MouseMsg, row int, top bool) (tea.Cmd, bool) {
+	if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+		return nil, true
+	}
+
+	key, hit := s.widget.hitTest(msg.X, row, top)
+	if !hit {
+		return nil, true
+	}
+	for i, wgt := range s.widget.widgets {
+		if wgt.Key == key {
+			s.widget.focusedWidget = i
+		}
+	}
+	if handler := s.widget.clickHandlers[key]; handler != nil {
+		return handler(), true
+	}
+	return nil, true
+}
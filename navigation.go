@@ -0,0 +1,88 @@
+package skeleton
+
+// navigationHistoryLimit is the maximum number of entries kept in each direction.
+const navigationHistoryLimit = 50
+
+// navigationHistory is hold the back/forward stacks of the activated tabs.
+type navigationHistory struct {
+	back    []string
+	forward []string
+}
+
+// newNavigationHistory returns a new navigationHistory.
+func newNavigationHistory() *navigationHistory {
+	return &navigationHistory{}
+}
+
+// visit records the given key as the previously viewed tab. It clears the forward stack like browsers do.
+func (n *navigationHistory) visit(key string) {
+	n.back = pushLimited(n.back, key)
+	n.forward = nil
+}
+
+// pushLimited appends the key to the stack, dropping the oldest entries over the limit.
+func pushLimited(stack []string, key string) []string {
+	if len(stack) > 0 && stack[len(stack)-1] == key {
+		return stack
+	}
+	stack = append(stack, key)
+	if len(stack) > navigationHistoryLimit {
+		stack = stack[len(stack)-navigationHistoryLimit:]
+	}
+	return stack
+}
+
+// NavigateBack activates the previously viewed tab. It returns false if there is no tab to go back to.
+func (s *Skeleton) NavigateBack() bool {
+	return s.navigate(&s.navigation.back, &s.navigation.forward)
+}
+
+// NavigateForward activates the tab which was left by NavigateBack. It returns false if there is no tab to go forward to.
+func (s *Skeleton) NavigateForward() bool {
+	return s.navigate(&s.navigation.forward, &s.navigation.back)
+}
+
+// CanNavigateBack returns true if there is a tab to go back to.
+func (s *Skeleton) CanNavigateBack() bool {
+	return len(s.navigation.back) > 0
+}
+
+// CanNavigateForward returns true if there is a tab to go forward to.
+func (s *Skeleton) CanNavigateForward() bool {
+	return len(s.navigation.forward) > 0
+}
+
+// navigate pops the first reachable tab from the source stack, activates it and
+// pushes the current tab to the target stack.
+func (s *Skeleton) navigate(source, target *[]string) bool {
+	if s.IsTabsLocked() {
+		return false
+	}
+
+	for len(*source) > 0 {
+		key := (*source)[len(*source)-1]
+		*source = (*source)[:len(*source)-1]
+
+		// skip the closed, locked and disabled tabs
+		index := s.pageIndex(key)
+		if index < 0 || index == s.currentTab || !s.header.canActivate(key) {
+			continue
+		}
+
+		*target = pushLimited(*target, s.header.headers[s.currentTab].key)
+		s.activateTab(index)
+		s.updater.Update()
+		return true
+	}
+	return false
+}
+
+// pageIndex returns the index of the page by the given key, or -1 if it does not exist.
+func (s *Skeleton) pageIndex(key string) int {
+	for i, hdr := range s.header.headers {
+		if hdr.key == key {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,27 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// NewTabHandler is called when the user asks for a new tab, with the new tab key binding or
+// the "+" element of the tab bar. It is expected to add a page, e.g. with AddPage and SetActivePage.
+type NewTabHandler func() tea.Cmd
+
+// SetNewTabHandler sets the handler which creates a new tab. When it is set, a "+" element is
+// shown at the end of the tab bar. Nil removes the handler and the element.
+func (s *Skeleton) SetNewTabHandler(handler NewTabHandler) *Skeleton {
+	s.newTabHandler = handler
+	s.header.newTabButton = handler != nil
+	s.header.calculateTitleLength()
+	s.updater.Update()
+	return s
+}
+
+// NewTab invokes the registered NewTabHandler. It returns nil if there is no handler.
+func (s *Skeleton) NewTab() tea.Cmd {
+	if s.newTabHandler == nil {
+		return nil
+	}
+	return s.newTabHandler()
+}
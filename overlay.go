@@ -0,0 +1,43 @@
+package skeleton
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// placeOverlay renders fg centered on top of bg.
+func placeOverlay(fg, bg string) string {
+	x := max((lipgloss.Width(bg)-lipgloss.Width(fg))/2, 0)
+	y := max((lipgloss.Height(bg)-lipgloss.Height(fg))/2, 0)
+	return placeOverlayAt(x, y, fg, bg)
+}
+
+// placeOverlayAt renders fg on top of bg, the top left corner of fg is placed at the given cell.
+func placeOverlayAt(x, y int, fg, bg string) string {
+	fgLines := strings.Split(fg, "\n")
+	bgLines := strings.Split(bg, "\n")
+	fgWidth := lipgloss.Width(fg)
+
+	for i, fgLine := range fgLines {
+		row := y + i
+		if row < 0 {
+			continue
+		}
+		if row >= len(bgLines) {
+			break
+		}
+
+		bgLine := bgLines[row]
+		if w := ansi.StringWidth(bgLine); w < x+fgWidth {
+			bgLine += strings.Repeat(" ", x+fgWidth-w)
+		}
+
+		left := ansi.Truncate(bgLine, x, "")
+		right := ansi.TruncateLeft(bgLine, x+ansi.StringWidth(fgLine), "")
+		bgLines[row] = left + fgLine + right
+	}
+
+	return strings.Join(bgLines, "\n")
+}
@@ -0,0 +1,38 @@
+package skeleton
+
+import "github.com/charmbracelet/lipgloss"
+
+// SetPageAlignment sets the horizontal position of the content of the page by the given key, e.g. lipgloss.Left
+// for the list pages while the dashboards stay centered. It overrides SetPagePosition for that page.
+func (s *Skeleton) SetPageAlignment(key string, position lipgloss.Position) *Skeleton {
+	key = s.normalizeKey(key)
+	s.pageAlignments[key] = position
+	s.updater.Update()
+	return s
+}
+
+// ResetPageAlignment makes the page by the given key use the position set by SetPagePosition again.
+func (s *Skeleton) ResetPageAlignment(key string) *Skeleton {
+	key = s.normalizeKey(key)
+	delete(s.pageAlignments, key)
+	s.updater.Update()
+	return s
+}
+
+// GetPageAlignment returns the horizontal position of the content of the page by the given key,
+// it is the position set by SetPagePosition unless the page overrides it.
+func (s *Skeleton) GetPageAlignment(key string) lipgloss.Position {
+	key = s.normalizeKey(key)
+	if position, ok := s.pageAlignments[key]; ok {
+		return position
+	}
+	return s.properties.pagePosition
+}
+
+// activePageAlignment returns the horizontal position of the content of the active page.
+func (s *Skeleton) activePageAlignment() lipgloss.Position {
+	if s.currentTab < 0 || s.currentTab >= len(s.header.headers) {
+		return s.properties.pagePosition
+	}
+	return s.GetPageAlignment(s.header.headers[s.currentTab].key)
+}
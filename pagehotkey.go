@@ -0,0 +1,88 @@
+package skeleton
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	teakey "github.com/charmbracelet/bubbles/key"
+)
+
+// ErrKeyConflict is returned when a key is already bound to an action or to another page.
+var ErrKeyConflict = errors.New("skeleton: key is already bound")
+
+// BindKeyToPage binds the given key, e.g. "f1", to the page by the given key, so the page is activated by it
+// regardless of its position. The binding is kept while the page is closed and applies again when it is
+// reopened. It returns ErrKeyConflict if the key is bound to an action of the KeyMap or to another page.
+func (s *Skeleton) BindKeyToPage(keyName string, pageKey string) error {
+	pageKey = s.normalizeKey(pageKey)
+	if keyName == "" {
+		return fmt.Errorf("%w: empty key name", ErrInvalidKey)
+	}
+	if !s.validKey(pageKey) {
+		return fmt.Errorf("%w: %q", ErrInvalidKey, pageKey)
+	}
+
+	for action, keys := range s.KeyMap.KeyBindings() {
+		if slices.Contains(keys, keyName) {
+			return fmt.Errorf("%w: %q is bound to %s", ErrKeyConflict, keyName, action)
+		}
+	}
+	if bound, ok := s.pageHotkeys[keyName]; ok && bound != pageKey {
+		return fmt.Errorf("%w: %q is bound to page %q", ErrKeyConflict, keyName, bound)
+	}
+
+	s.pageHotkeys[keyName] = pageKey
+	return nil
+}
+
+// UnbindPageKey removes the binding of the given key to a page.
+func (s *Skeleton) UnbindPageKey(keyName string) *Skeleton {
+	delete(s.pageHotkeys, keyName)
+	return s
+}
+
+// GetPageKeyBindings returns the keys which are bound to pages, by the page keys.
+func (s *Skeleton) GetPageKeyBindings() map[string][]string {
+	bindings := make(map[string][]string)
+	for keyName, pageKey := range s.pageHotkeys {
+		bindings[pageKey] = append(bindings[pageKey], keyName)
+	}
+	for _, keys := range bindings {
+		slices.Sort(keys)
+	}
+	return bindings
+}
+
+// pageHotkeyMap is hold the key bindings of the pages, it implements help.KeyMap for the help overlay.
+type pageHotkeyMap []teakey.Binding
+
+// ShortHelp returns the key bindings of the pages.
+func (m pageHotkeyMap) ShortHelp() []teakey.Binding {
+	return m
+}
+
+// FullHelp returns the key bindings of the pages in a single column.
+func (m pageHotkeyMap) FullHelp() [][]teakey.Binding {
+	return [][]teakey.Binding{m}
+}
+
+// pageHotkeyHelp returns the key bindings of the open pages, sorted by their keys.
+func (s *Skeleton) pageHotkeyHelp() pageHotkeyMap {
+	keys := make([]string, 0, len(s.pageHotkeys))
+	for keyName := range s.pageHotkeys {
+		keys = append(keys, keyName)
+	}
+	slices.Sort(keys)
+
+	var bindings pageHotkeyMap
+	for _, keyName := range keys {
+		if index := s.pageIndex(s.pageHotkeys[keyName]); index >= 0 {
+			bindings = append(bindings, teakey.NewBinding(
+				teakey.WithKeys(keyName),
+				teakey.WithHelp(keyName, s.header.headers[index].title),
+			))
+		}
+	}
+	return bindings
+}
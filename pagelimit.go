@@ -0,0 +1,97 @@
+package skeleton
+
+// PageLimitPolicy decides what happens when a page is added while the maximum number of pages are open.
+type PageLimitPolicy int
+
+const (
+	// EvictOldest closes the least recently viewed page which can be closed: it is not active,
+	// locked, pinned or dirty. The closed page can be reopened like a page closed by the user.
+	// If no page can be closed, the new page is not added.
+	EvictOldest PageLimitPolicy = iota
+	// RejectNew does not add the new page.
+	RejectNew
+)
+
+// pageLimit is hold the maximum number of the open pages and the recency of the pages.
+type pageLimit struct {
+	max    int
+	policy PageLimitPolicy
+
+	// lastViewed is hold the view clock of the pages by their keys, the highest one is the most recently viewed
+	lastViewed map[string]uint64
+	clock      uint64
+}
+
+// newPageLimit returns a page limit without a maximum.
+func newPageLimit() *pageLimit {
+	return &pageLimit{
+		lastViewed: make(map[string]uint64),
+	}
+}
+
+// view records the page by the given key as the most recently viewed one.
+func (l *pageLimit) view(key string) {
+	l.clock++
+	l.lastViewed[key] = l.clock
+}
+
+// SetMaxPages limits the number of the open pages to n, the policy decides what happens when
+// a page is added at the limit. Zero removes the limit. Pages which are already open are not closed.
+func (s *Skeleton) SetMaxPages(n int, policy PageLimitPolicy) *Skeleton {
+	s.pageLimit.max = max(n, 0)
+	s.pageLimit.policy = policy
+	return s
+}
+
+// GetMaxPages returns the maximum number of the open pages and the policy, zero is unlimited.
+func (s *Skeleton) GetMaxPages() (int, PageLimitPolicy) {
+	return s.pageLimit.max, s.pageLimit.policy
+}
+
+// CanAddPage returns true if a new page can be added without exceeding the maximum number of pages,
+// or if a page can be closed to make room for it.
+func (s *Skeleton) CanAddPage() bool {
+	if s.pageLimit.max == 0 || len(s.pages) < s.pageLimit.max {
+		return true
+	}
+	if s.pageLimit.policy == RejectNew {
+		return false
+	}
+	_, ok := s.evictionCandidate()
+	return ok
+}
+
+// makeRoomForPage closes the pages over the limit by the policy, so a new page can be added.
+// It returns false if the new page can not be added.
+func (s *Skeleton) makeRoomForPage() bool {
+	if s.pageLimit.max == 0 {
+		return true
+	}
+	for len(s.pages) >= s.pageLimit.max {
+		if s.pageLimit.policy == RejectNew {
+			return false
+		}
+		key, ok := s.evictionCandidate()
+		if !ok {
+			return false
+		}
+		s.deleteMsg(key)
+	}
+	return true
+}
+
+// evictionCandidate returns the key of the least recently viewed page which can be closed.
+func (s *Skeleton) evictionCandidate() (string, bool) {
+	var candidate string
+	var oldest uint64
+	found := false
+	for i, hdr := range s.header.headers {
+		if i == s.currentTab || !s.header.canCloseTab(hdr.key) || s.IsPageDirty(hdr.key) {
+			continue
+		}
+		if viewed := s.pageLimit.lastViewed[hdr.key]; !found || viewed < oldest {
+			candidate, oldest, found = hdr.key, viewed, true
+		}
+	}
+	return candidate, found
+}
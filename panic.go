@@ -0,0 +1,61 @@
+package skeleton
+
+import (
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// terminalResetSequence exits the alternate screen, shows the cursor and disables the mouse and
+// bracketed paste. It is written when a panic happens before the program is known.
+const terminalResetSequence = ansi.ResetAltScreenSaveCursorMode + ansi.ShowCursor +
+	ansi.ResetNormalMouseMode + ansi.ResetButtonEventMouseMode + ansi.ResetAnyEventMouseMode +
+	ansi.ResetSgrExtMouseMode + ansi.ResetBracketedPasteMode
+
+// Run runs the Skeleton in a new program with the given options and returns when it quits.
+// The terminal is always restored before a panic is re-raised: panics of the tickers, timers and
+// file watchers are re-raised, and panics of the pages and commands too with tea.WithoutCatchPanics.
+// The signals are handled by HandleSignals and the shutdown hooks are called before it returns.
+func (s *Skeleton) Run(opts ...tea.ProgramOption) error {
+	p := tea.NewProgram(s, opts...)
+	s.SetProgram(p)
+	defer s.restoreOnPanic()
+
+	stop := s.HandleSignals()
+	defer stop()
+
+	_, err := p.Run()
+	s.runShutdown()
+	return err
+}
+
+// SetProgram sets the program which runs the Skeleton, it is set by Run. The program is used
+// to restore the terminal when a goroutine of the Skeleton panics.
+func (s *Skeleton) SetProgram(p *tea.Program) *Skeleton {
+	s.programMu.Lock()
+	s.program = p
+	s.programMu.Unlock()
+	return s
+}
+
+// restoreOnPanic restores the terminal and re-panics, it has to be deferred at the top of a goroutine.
+func (s *Skeleton) restoreOnPanic() {
+	if r := recover(); r != nil {
+		s.restoreTerminal()
+		panic(r)
+	}
+}
+
+// restoreTerminal restores the terminal to its original state. Without a program only the escape
+// sequences are written, the input mode can not be restored.
+func (s *Skeleton) restoreTerminal() {
+	s.programMu.Lock()
+	p := s.program
+	s.programMu.Unlock()
+
+	if p != nil && p.ReleaseTerminal() == nil {
+		return
+	}
+	_, _ = os.Stdout.WriteString(terminalResetSequence)
+}
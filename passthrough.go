@@ -0,0 +1,124 @@
+package skeleton
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Multiplexer is the terminal multiplexer the application runs in. The sequences which the multiplexer
+// does not forward on its own are wrapped in its passthrough sequence, so they reach the outer terminal.
+type Multiplexer int
+
+const (
+	// MultiplexerAuto detects the multiplexer from the environment.
+	MultiplexerAuto Multiplexer = iota
+	// MultiplexerNone sends the sequences as they are.
+	MultiplexerNone
+	// MultiplexerTmux wraps the sequences for tmux, it needs the allow-passthrough option to be on.
+	MultiplexerTmux
+	// MultiplexerScreen wraps the sequences for GNU Screen.
+	MultiplexerScreen
+)
+
+// screenPassthroughLimit is the longest string sequence GNU Screen accepts, longer ones are chunked.
+const screenPassthroughLimit = 768
+
+// SetMultiplexer overrides the multiplexer detection. MultiplexerAuto restores the detection.
+func (s *Skeleton) SetMultiplexer(multiplexer Multiplexer) *Skeleton {
+	s.properties.multiplexer = multiplexer
+	return s
+}
+
+// GetMultiplexer returns the multiplexer the application runs in, the detected one if it is not overridden.
+func (s *Skeleton) GetMultiplexer() Multiplexer {
+	if s.properties.multiplexer == MultiplexerAuto {
+		return detectMultiplexer()
+	}
+	return s.properties.multiplexer
+}
+
+// detectMultiplexer detects the multiplexer from the environment.
+func detectMultiplexer() Multiplexer {
+	switch {
+	case os.Getenv("TMUX") != "":
+		return MultiplexerTmux
+	case os.Getenv("STY") != "":
+		return MultiplexerScreen
+	}
+
+	term := os.Getenv("TERM")
+	switch {
+	case strings.HasPrefix(term, "tmux"):
+		return MultiplexerTmux
+	case strings.HasPrefix(term, "screen"):
+		return MultiplexerScreen
+	}
+	return MultiplexerNone
+}
+
+// passthrough wraps the given sequence for the multiplexer.
+func (s *Skeleton) passthrough(seq string) string {
+	switch s.GetMultiplexer() {
+	case MultiplexerTmux:
+		return ansi.TmuxPassthrough(seq)
+	case MultiplexerScreen:
+		return ansi.ScreenPassthrough(seq, screenPassthroughLimit)
+	default:
+		return seq
+	}
+}
+
+// SetOutput sets the output of the program which runs the Skeleton, the terminal sequences which bubbletea
+// has no command for, e.g. the clipboard of CopyToClipboard, are written to it. It is os.Stdout by default,
+// like the output of the programs; programs started with tea.WithOutput have to set the same writer.
+// The web handler sets it to the connection of the session.
+func (s *Skeleton) SetOutput(w io.Writer) *Skeleton {
+	s.programMu.Lock()
+	s.output = w
+	s.programMu.Unlock()
+	return s
+}
+
+// writeSequence returns a command which writes the given sequence to the output of the program.
+// The sequence is written at once, so it is not split by the frames the renderer writes meanwhile.
+func (s *Skeleton) writeSequence(seq string) tea.Cmd {
+	return func() tea.Msg {
+		s.programMu.Lock()
+		output := s.output
+		s.programMu.Unlock()
+
+		if output == nil {
+			output = os.Stdout
+		}
+		_, _ = io.WriteString(output, seq)
+		return nil
+	}
+}
+
+// CopyToClipboard returns a command which copies the given text to the system clipboard of the
+// terminal with OSC 52. Inside a multiplexer the sequence is passed through to the outer terminal.
+func (s *Skeleton) CopyToClipboard(text string) tea.Cmd {
+	return s.writeSequence(s.passthrough(ansi.SetSystemClipboard(text)))
+}
+
+// SetTerminalTitle returns a command which sets the title of the terminal window. The program writes it,
+// inside a multiplexer it is the title of the pane, which tmux shows as the window title with set-titles.
+func (s *Skeleton) SetTerminalTitle(title string) tea.Cmd {
+	return tea.SetWindowTitle(title)
+}
+
+// Hyperlink returns the given text as a clickable link to the url, to be used in the views. tmux
+// forwards links on its own since 3.4. GNU Screen drops them, so the url is written after the text.
+func (s *Skeleton) Hyperlink(url string, text string) string {
+	if s.GetMultiplexer() == MultiplexerScreen {
+		if text == url {
+			return text
+		}
+		return text + " (" + url + ")"
+	}
+	return ansi.SetHyperlink(url) + text + ansi.ResetHyperlink()
+}
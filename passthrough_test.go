@@ -0,0 +1,44 @@
+package skeleton
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestCopyToClipboardWritesToTheOutput(t *testing.T) {
+	tests := []struct {
+		name        string
+		multiplexer Multiplexer
+		want        string
+	}{
+		{name: "none", multiplexer: MultiplexerNone, want: ansi.SetSystemClipboard("copied")},
+		{name: "tmux", multiplexer: MultiplexerTmux, want: ansi.TmuxPassthrough(ansi.SetSystemClipboard("copied"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var output strings.Builder
+			s := NewSkeleton().SetMultiplexer(tt.multiplexer).SetOutput(&output)
+
+			if msg := s.CopyToClipboard("copied")(); msg != nil {
+				t.Errorf("command returned %v, want nil", msg)
+			}
+			if got := output.String(); got != tt.want {
+				t.Errorf("output is %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetTerminalTitleUsesTheProgram(t *testing.T) {
+	s := NewSkeleton().SetMultiplexer(MultiplexerTmux)
+
+	got := s.SetTerminalTitle("title")()
+	if want := tea.SetWindowTitle("title")(); !reflect.DeepEqual(got, want) {
+		t.Errorf("command returned %#v, want %#v", got, want)
+	}
+}
@@ -0,0 +1,102 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// notPermittedReason is shown when a tab which is not allowed by the permissions is selected.
+const notPermittedReason = "not available in this session"
+
+// Permissions are the capabilities of a session, e.g. a viewer of a dashboard served over SSH or the web.
+// They are enforced by the Skeleton before the input reaches the pages.
+type Permissions struct {
+	// ReadOnly drops the input which changes the application. The keys and the mouse events are not
+	// forwarded to the pages, and the tabs can not be closed, moved, renamed or added. Switching the tabs,
+	// the navigation history and the help stay available.
+	ReadOnly bool
+
+	// NoQuit ignores the quit key binding, so a viewer can not stop a shared application
+	NoQuit bool
+
+	// AllowedTabs are the keys of the tabs which can be switched to, nil allows all of them
+	AllowedTabs []string
+}
+
+// SetPermissions sets the capabilities of the session. If the active tab is not allowed,
+// the first allowed tab is activated.
+func (s *Skeleton) SetPermissions(permissions Permissions) *Skeleton {
+	s.permissions = permissions
+	s.header.allowedTabs = nil
+	if permissions.AllowedTabs != nil {
+		s.header.allowedTabs = make(map[string]bool, len(permissions.AllowedTabs))
+		for _, tabKey := range permissions.AllowedTabs {
+			s.header.allowedTabs[s.normalizeKey(tabKey)] = true
+		}
+	}
+
+	if len(s.header.headers) > 0 && !s.header.isTabAllowed(s.GetActivePage()) {
+		for i, hdr := range s.header.headers {
+			if s.header.canActivate(hdr.key) {
+				s.setCurrentTab(i)
+				break
+			}
+		}
+	}
+
+	s.header.calculateTitleLength()
+	s.updater.Update()
+	return s
+}
+
+// GetPermissions returns the capabilities of the session.
+func (s *Skeleton) GetPermissions() Permissions {
+	return s.permissions
+}
+
+// isTabAllowed returns true if the permissions allow switching to the tab by the given key.
+func (h *header) isTabAllowed(key string) bool {
+	return h.allowedTabs == nil || h.allowedTabs[key]
+}
+
+// isTabUnavailable returns true if the tab by the given key is rendered greyed out, it is disabled
+// or not allowed by the permissions.
+func (h *header) isTabUnavailable(key string) bool {
+	return h.IsTabDisabled(key) || !h.isTabAllowed(key)
+}
+
+// permitted returns false if the permissions of the session drop the given input.
+func (s *Skeleton) permitted(msg tea.Msg) bool {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if key.Matches(msg, s.KeyMap.Quit) {
+			return !s.permissions.NoQuit
+		}
+		if !s.permissions.ReadOnly {
+			return true
+		}
+		if s.IsModalOpen() || s.GetFocusedRegion() != RegionBody {
+			return false
+		}
+		if _, isHotkey := s.pageHotkeys[msg.String()]; isHotkey {
+			return true
+		}
+		return key.Matches(msg, s.KeyMap.SwitchTabLeft, s.KeyMap.SwitchTabRight,
+			s.KeyMap.NextTabPage, s.KeyMap.PrevTabPage, s.KeyMap.HistoryBack, s.KeyMap.HistoryForward,
+			s.KeyMap.Help, s.KeyMap.CycleWorkspace) ||
+			key.Matches(msg, s.KeyMap.JumpToTab...)
+
+	case tea.MouseMsg:
+		if !s.permissions.ReadOnly {
+			return true
+		}
+		// the tabs can be switched by the header and the sidebar, the rest is dropped
+		_, inHeader := s.edgesAt(msg.Y)
+		if !inHeader {
+			return s.header.isSidebar() && msg.X >= 1 && msg.X <= s.header.sidebarWidth()
+		}
+		box, hit := s.header.hitTest(msg.X)
+		return !hit || (box.index >= 0 && !s.header.isOnCloseGlyph(box, msg.X))
+	}
+	return true
+}
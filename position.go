@@ -0,0 +1,83 @@
+package skeleton
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// HeaderPosition is the edge of the Skeleton which the header (tab strip) is placed at.
+type HeaderPosition int
+
+const (
+	// HeaderTop places the header above the content and the widgets below it.
+	HeaderTop HeaderPosition = iota
+	// HeaderBottom places the header below the content and the widgets above it.
+	HeaderBottom
+)
+
+// mirrorGlyphs swaps the box drawing characters of the borders with their vertical mirror.
+var mirrorGlyphs = strings.NewReplacer(
+	"╭", "╰", "╰", "╭", "╮", "╯", "╯", "╮",
+	"┌", "└", "└", "┌", "┐", "┘", "┘", "┐",
+	"╔", "╚", "╚", "╔", "╗", "╝", "╝", "╗",
+	"┏", "┗", "┗", "┏", "┓", "┛", "┛", "┓",
+	"┬", "┴", "┴", "┬", "╦", "╩", "╩", "╦", "┳", "┻", "┻", "┳",
+	"▀", "▄", "▄", "▀",
+)
+
+// mirroredGlyphs returns the given glyph set with the box drawing characters of its borders mirrored.
+// The header and the widgets are drawn with it when they are flipped, so only their own borders are
+// mirrored and the tab titles and the widget values are kept as they are.
+func mirroredGlyphs(glyphs GlyphSet) GlyphSet {
+	for _, border := range []*lipgloss.Border{&glyphs.Frame, &glyphs.ActiveTab, &glyphs.InactiveTab, &glyphs.DisabledTab, &glyphs.Widget} {
+		for _, side := range []*string{
+			&border.Top, &border.Bottom, &border.Left, &border.Right,
+			&border.TopLeft, &border.TopRight, &border.BottomLeft, &border.BottomRight,
+			&border.MiddleLeft, &border.MiddleRight, &border.Middle, &border.MiddleTop, &border.MiddleBottom,
+		} {
+			*side = mirrorGlyphs.Replace(*side)
+		}
+	}
+	for _, glyph := range []*string{&glyphs.TabLeft, &glyphs.TabRight, &glyphs.WidgetLeft, &glyphs.WidgetRight, &glyphs.Separator} {
+		*glyph = mirrorGlyphs.Replace(*glyph)
+	}
+	return glyphs
+}
+
+// mirrorVertical flips the rendered view upside down, so the header and the widgets can be
+// drawn on the opposite edge of the frame. The lines keep their styles since they are rendered per line,
+// the borders are drawn with the mirrored glyphs beforehand, see mirroredGlyphs.
+func mirrorVertical(view string) string {
+	lines := strings.Split(view, "\n")
+	slices.Reverse(lines)
+	return strings.Join(lines, "\n")
+}
+
+// isHeaderAtBottom returns true if the header is placed below the content.
+func (s *Skeleton) isHeaderAtBottom() bool {
+	return s.properties.headerPosition == HeaderBottom
+}
+
+// placeRegion returns the given header or footer view, mirrored when the header position swaps the edges.
+// Views of a custom renderer are kept as they are, the renderer is responsible for their look.
+func (s *Skeleton) placeRegion(view string, custom bool) string {
+	if !s.isHeaderAtBottom() || custom {
+		return view
+	}
+	return mirrorVertical(view)
+}
+
+// SetHeaderPosition sets the edge which the header is placed at. When the header is at the bottom,
+// the widgets are moved to the top and the content is rendered between them.
+func (s *Skeleton) SetHeaderPosition(position HeaderPosition) *Skeleton {
+	s.properties.headerPosition = position
+	s.applyGlyphs()
+	return s
+}
+
+// GetHeaderPosition returns the edge which the header is placed at.
+func (s *Skeleton) GetHeaderPosition() HeaderPosition {
+	return s.properties.headerPosition
+}
@@ -0,0 +1,31 @@
+package skeleton
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestHeaderAtBottomKeepsBoxGlyphsOfContent(t *testing.T) {
+	s := NewSkeleton()
+	s.AddPage("page", "┌Box┐", newTestPage())
+	s.AddWidget("w", "╭─╮")
+	s.SetHeaderPosition(HeaderBottom)
+
+	view := ansi.Strip(s.RenderSnapshot(40, 12))
+	for _, content := range []string{"┌Box┐", "╭─╮"} {
+		if !strings.Contains(view, content) {
+			t.Errorf("view does not contain %q:\n%s", content, view)
+		}
+	}
+
+	// the borders of the header and the widgets are still mirrored
+	lines := strings.Split(view, "\n")
+	if line := lines[len(lines)-2]; !strings.HasPrefix(line, "╰┤") || !strings.HasSuffix(line, "╯") {
+		t.Errorf("the header at the bottom is not joined to the bottom border: %q", line)
+	}
+	if line := lines[1]; !strings.HasPrefix(line, "╭") || !strings.HasSuffix(line, "├╮") {
+		t.Errorf("the widgets at the top are not joined to the top border: %q", line)
+	}
+}
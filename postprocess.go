@@ -0,0 +1,77 @@
+package skeleton
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// ViewProcessor post-processes the rendered output of a page before the Skeleton frames it.
+type ViewProcessor func(view string) string
+
+// AddViewProcessor registers a processor which is applied to the rendered output of every page,
+// after the processors of the page itself.
+func (s *Skeleton) AddViewProcessor(processor ViewProcessor) *Skeleton {
+	if processor != nil {
+		s.viewProcessors = append(s.viewProcessors, processor)
+	}
+	s.updater.Update()
+	return s
+}
+
+// AddPageViewProcessor registers a processor which is applied to the rendered output of the page by
+// the given key, e.g. syntax highlighting or line numbering. Processors run in registration order.
+func (s *Skeleton) AddPageViewProcessor(key string, processor ViewProcessor) *Skeleton {
+	key = s.normalizeKey(key)
+	if processor != nil {
+		s.pageViewProcessors[key] = append(s.pageViewProcessors[key], processor)
+	}
+	s.updater.Update()
+	return s
+}
+
+// ClearPageViewProcessors removes the processors of the page by the given key.
+func (s *Skeleton) ClearPageViewProcessors(key string) *Skeleton {
+	key = s.normalizeKey(key)
+	delete(s.pageViewProcessors, key)
+	s.updater.Update()
+	return s
+}
+
+// ClearViewProcessors removes the processors which are applied to every page.
+func (s *Skeleton) ClearViewProcessors() *Skeleton {
+	s.viewProcessors = nil
+	s.updater.Update()
+	return s
+}
+
+// processView applies the processors of the page by the given key and the global ones to the view,
+// and wraps it if the page wraps.
+func (s *Skeleton) processView(key string, view string) string {
+	for _, processor := range s.pageViewProcessors[key] {
+		view = processor(view)
+	}
+	for _, processor := range s.viewProcessors {
+		view = processor(view)
+	}
+	return s.wrapView(key, view)
+}
+
+// LineNumbersProcessor returns a processor which prefixes every line with its number.
+func LineNumbersProcessor() ViewProcessor {
+	return func(view string) string {
+		lines := strings.Split(view, "\n")
+		width := len(fmt.Sprint(len(lines)))
+		for i, line := range lines {
+			lines[i] = fmt.Sprintf("%*d │ %s", width, i+1, line)
+		}
+		return strings.Join(lines, "\n")
+	}
+}
+
+// StripANSIProcessor returns a processor which removes the escape sequences, e.g. to sanitize
+// untrusted content which could move the cursor or change the terminal state.
+func StripANSIProcessor() ViewProcessor {
+	return ansi.Strip
+}
@@ -0,0 +1,56 @@
+package skeleton
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Size is a terminal size.
+type Size struct {
+	Width  int
+	Height int
+}
+
+// String returns the size as "WIDTHxHEIGHT".
+func (z Size) String() string {
+	return fmt.Sprintf("%dx%d", z.Width, z.Height)
+}
+
+// CommonSizes are the terminal sizes used by PreviewSizes when no sizes are given.
+var CommonSizes = []Size{
+	{Width: 80, Height: 24},
+	{Width: 120, Height: 40},
+	{Width: 200, Height: 50},
+}
+
+// Preview is a frame rendered at a terminal size.
+type Preview struct {
+	// Size is the terminal size the frame is rendered at
+	Size Size
+
+	// Frame is the rendered frame with its styling
+	Frame string
+}
+
+// Plain returns the frame without the styling escape sequences.
+func (p Preview) Plain() string {
+	return ansi.Strip(p.Frame)
+}
+
+// PreviewSizes renders the Skeleton at each of the given sizes, so the responsive behavior can be
+// validated in tests and documentation generators. CommonSizes are used if no sizes are given.
+func PreviewSizes(s *Skeleton, sizes []Size) []Preview {
+	if len(sizes) == 0 {
+		sizes = CommonSizes
+	}
+
+	previews := make([]Preview, len(sizes))
+	for i, size := range sizes {
+		previews[i] = Preview{
+			Size:  size,
+			Frame: s.RenderSnapshot(size.Width, size.Height),
+		}
+	}
+	return previews
+}
@@ -0,0 +1,152 @@
+package skeleton
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"sort"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ModuleTiming is hold the timing counters of a module, e.g. the updates of a page.
+type ModuleTiming struct {
+	Module string        `json:"module"`
+	Calls  int64         `json:"calls"`
+	Total  time.Duration `json:"total"`
+	Max    time.Duration `json:"max"`
+}
+
+// Average returns the average time of a call.
+func (m ModuleTiming) Average() time.Duration {
+	if m.Calls == 0 {
+		return 0
+	}
+	return m.Total / time.Duration(m.Calls)
+}
+
+// profiler is hold the pprof server and the timing counters of the modules.
+type profiler struct {
+	mu       sync.Mutex
+	server   *http.Server
+	addr     string
+	counters map[string]*ModuleTiming
+}
+
+// EnableProfiling serves pprof on the given address (e.g. "localhost:6060") under /debug/pprof/ and
+// the timing counters of the modules under /debug/skeleton, while the TUI runs.
+func (s *Skeleton) EnableProfiling(addr string) error {
+	s.profiler.mu.Lock()
+	defer s.profiler.mu.Unlock()
+
+	if s.profiler.server != nil {
+		return errors.New("skeleton: profiling is already enabled on " + s.profiler.addr)
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.HandleFunc("/debug/skeleton", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(s.GetModuleTimings())
+	})
+
+	s.profiler.server = &http.Server{Handler: mux}
+	s.profiler.addr = listener.Addr().String()
+	s.profiler.counters = make(map[string]*ModuleTiming)
+
+	go func(server *http.Server) {
+		_ = server.Serve(listener)
+	}(s.profiler.server)
+
+	return nil
+}
+
+// DisableProfiling stops the pprof server and the timing counters.
+func (s *Skeleton) DisableProfiling() error {
+	s.profiler.mu.Lock()
+	defer s.profiler.mu.Unlock()
+
+	if s.profiler.server == nil {
+		return nil
+	}
+
+	err := s.profiler.server.Close()
+	s.profiler.server = nil
+	s.profiler.addr = ""
+	s.profiler.counters = nil
+	return err
+}
+
+// GetProfilingAddr returns the address of the pprof server, it is empty if profiling is disabled.
+func (s *Skeleton) GetProfilingAddr() string {
+	s.profiler.mu.Lock()
+	defer s.profiler.mu.Unlock()
+	return s.profiler.addr
+}
+
+// GetModuleTimings returns the timing counters of the modules sorted by their names.
+// It is empty if profiling is disabled.
+func (s *Skeleton) GetModuleTimings() []ModuleTiming {
+	s.profiler.mu.Lock()
+	defer s.profiler.mu.Unlock()
+
+	timings := make([]ModuleTiming, 0, len(s.profiler.counters))
+	for _, timing := range s.profiler.counters {
+		timings = append(timings, *timing)
+	}
+	sort.Slice(timings, func(i, j int) bool {
+		return timings[i].Module < timings[j].Module
+	})
+	return timings
+}
+
+// record adds a call with the given duration to the counters of the module.
+func (p *profiler) record(module string, duration time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.counters == nil {
+		return
+	}
+
+	timing, ok := p.counters[module]
+	if !ok {
+		timing = &ModuleTiming{Module: module}
+		p.counters[module] = timing
+	}
+	timing.Calls++
+	timing.Total += duration
+	if duration > timing.Max {
+		timing.Max = duration
+	}
+}
+
+// updatePageAt delivers the message to the page at the given index and records its timing.
+func (s *Skeleton) updatePageAt(index int, msg tea.Msg) tea.Cmd {
+	start := time.Now()
+	var cmd tea.Cmd
+	s.pages[index], cmd = s.pages[index].Update(msg)
+	s.profiler.record("page/"+s.header.headers[index].key+"/update", time.Since(start))
+	return cmd
+}
+
+// viewPageAt renders the page at the given index and records its timing.
+func (s *Skeleton) viewPageAt(index int) string {
+	start := time.Now()
+	view := s.pages[index].View()
+	s.profiler.record("page/"+s.header.headers[index].key+"/view", time.Since(start))
+	return view
+}
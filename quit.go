@@ -0,0 +1,56 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// QuitRequestHandler is called when the user presses the quit key. It returns true to quit
+// immediately, or false to cancel; the application can call Quit later, e.g. after a confirmation.
+type QuitRequestHandler func() bool
+
+// quitMsg is sent by Quit to quit the application.
+type quitMsg struct{}
+
+// SetOnQuitRequested sets the handler which is called before the application quits by the quit key.
+// Nil restores quitting immediately.
+func (s *Skeleton) SetOnQuitRequested(handler QuitRequestHandler) *Skeleton {
+	s.onQuitRequested = handler
+	return s
+}
+
+// SetQuitKeyEnabled enables or disables the built-in handling of the quit key. If it is disabled,
+// the quit key is delivered to the pages like any other key.
+func (s *Skeleton) SetQuitKeyEnabled(enabled bool) *Skeleton {
+	s.quitKeyDisabled = !enabled
+	return s
+}
+
+// IsQuitKeyEnabled returns the built-in handling of the quit key is enabled or not.
+func (s *Skeleton) IsQuitKeyEnabled() bool {
+	return !s.quitKeyDisabled
+}
+
+// ConfirmQuit sets a quit handler which asks the user to confirm quitting with the given message.
+func (s *Skeleton) ConfirmQuit(title string, message string) *Skeleton {
+	return s.SetOnQuitRequested(func() bool {
+		s.ShowConfirm(title, message, func(confirmed bool) {
+			if confirmed {
+				s.Quit()
+			}
+		})
+		return false
+	})
+}
+
+// Quit quits the application, the quit handler is not called.
+func (s *Skeleton) Quit() {
+	s.updater.UpdateWithMsg(quitMsg{})
+}
+
+// requestQuit asks the quit handler and returns tea.Quit if the application should quit.
+func (s *Skeleton) requestQuit() tea.Cmd {
+	if s.onQuitRequested != nil && !s.onQuitRequested() {
+		return nil
+	}
+	return tea.Quit
+}
@@ -0,0 +1,185 @@
+package skeleton
+
+// HeaderRenderer draws the tab bar. It replaces the built-in tab bar while the
+// navigation and the state of the tabs are still managed by the Skeleton.
+type HeaderRenderer interface {
+	// RenderHeader returns the rendered tab bar for the given state.
+	RenderHeader(state HeaderState) string
+}
+
+// HeaderRendererFunc is an adapter to use ordinary functions as HeaderRenderer.
+type HeaderRendererFunc func(state HeaderState) string
+
+// RenderHeader calls f(state).
+func (f HeaderRendererFunc) RenderHeader(state HeaderState) string {
+	return f(state)
+}
+
+// HeaderState is hold the state of the tab bar which is passed to the HeaderRenderer.
+type HeaderState struct {
+	// Tabs are hold the tabs in their order
+	Tabs []TabState
+
+	// ActiveIndex is the index of the active tab
+	ActiveIndex int
+
+	// Width is the width of the terminal
+	Width int
+
+	// BorderColor is the border color of the Skeleton
+	BorderColor string
+
+	// Glyphs is the glyph set in use
+	Glyphs GlyphSet
+
+	// RightContent is the content placed flush-right on the header line, see SetHeaderRightContent
+	RightContent string
+}
+
+// TabState is hold the state of a single tab.
+type TabState struct {
+	// Key is unique key of the page
+	Key string
+
+	// Title is the title of the page
+	Title string
+
+	// Icon is the icon of the tab, it is empty if not set
+	Icon string
+
+	// Suffix is the suffix segment of the tab, it is empty if not set
+	Suffix string
+
+	// Badge is the badge count of the tab, it is zero if not set
+	Badge int
+
+	// Description is the description of the tab, it is empty if not set
+	Description string
+
+	// Workspace is the workspace of the tab, Hidden reports it is not in the active workspace
+	Workspace string
+	Hidden    bool
+
+	// Active reports the tab is the active one
+	Active bool
+
+	// Locked reports the tab is locked, it can not be switched to
+	Locked bool
+
+	// Disabled reports the tab is disabled, it can not be switched to and it is rendered greyed out
+	Disabled bool
+}
+
+// headerState returns the current state of the header.
+func (h *header) headerState() HeaderState {
+	tabs := make([]TabState, len(h.headers))
+	for i, hdr := range h.headers {
+		tabs[i] = TabState{
+			Key:   hdr.key,
+			Title: hdr.title,
+			Icon:  h.tabIcons[hdr.key],
+			Badge: h.tabBadges[hdr.key],
+
+			Suffix: h.tabSuffixes[hdr.key],
+
+			Description: h.tabDescriptions[hdr.key],
+
+			Workspace: h.tabWorkspaces[hdr.key],
+			Hidden:    !h.isVisible(i),
+			Active:    i == h.currentTab,
+			Locked:    h.IsTabLocked(hdr.key),
+			Disabled:  h.IsTabDisabled(hdr.key),
+		}
+	}
+
+	return HeaderState{
+		Tabs:        tabs,
+		ActiveIndex: h.currentTab,
+		Width:       h.viewport.Width,
+		BorderColor: h.properties.borderColor,
+		Glyphs:      h.properties.rendererGlyphs,
+
+		RightContent: h.rightContent,
+	}
+}
+
+// SetHeaderRenderer replaces the built-in tab bar with the given renderer. Nil restores the built-in one.
+func (s *Skeleton) SetHeaderRenderer(renderer HeaderRenderer) *Skeleton {
+	s.header.SetRenderer(renderer)
+	s.updater.Update()
+	return s
+}
+
+// WidgetRenderer draws the widget bar (footer). It replaces the built-in widget bar while
+// the widgets are still managed by the Skeleton through the key/value API.
+type WidgetRenderer interface {
+	// RenderWidgets returns the rendered widget bar for the given state. If the rendered
+	// bar is wider than the terminal, the Skeleton reports that the terminal is too small.
+	RenderWidgets(state WidgetState) string
+}
+
+// WidgetRendererFunc is an adapter to use ordinary functions as WidgetRenderer.
+type WidgetRendererFunc func(state WidgetState) string
+
+// RenderWidgets calls f(state).
+func (f WidgetRendererFunc) RenderWidgets(state WidgetState) string {
+	return f(state)
+}
+
+// WidgetState is hold the state of the widget bar which is passed to the WidgetRenderer.
+type WidgetState struct {
+	// Widgets are hold the widgets in their order
+	Widgets []WidgetItem
+
+	// Width is the width of the terminal
+	Width int
+
+	// BorderColor is the border color of the Skeleton
+	BorderColor string
+
+	// Glyphs is the glyph set in use
+	Glyphs GlyphSet
+}
+
+// WidgetItem is hold a single widget.
+type WidgetItem struct {
+	// Key is unique key of the widget
+	Key string
+
+	// Value is the content of the widget
+	Value string
+
+	// Align is the group of the footer the widget is placed in
+	Align WidgetAlignment
+
+	// Priority is the priority of the widget, the lowest ones are dropped first when they do not fit
+	Priority int
+}
+
+// widgetState returns the current state of the widget bar.
+func (w *widget) widgetState() WidgetState {
+	shown := w.shownWidgets()
+	items := make([]WidgetItem, len(shown))
+	for i, wgt := range shown {
+		items[i] = WidgetItem{
+			Key:      wgt.Key,
+			Value:    wgt.Value,
+			Align:    wgt.Align,
+			Priority: wgt.Priority,
+		}
+	}
+
+	return WidgetState{
+		Widgets:     items,
+		Width:       w.viewport.Width,
+		BorderColor: w.properties.borderColor,
+		Glyphs:      w.properties.rendererGlyphs,
+	}
+}
+
+// SetWidgetRenderer replaces the built-in widget bar with the given renderer. Nil restores the built-in one.
+func (s *Skeleton) SetWidgetRenderer(renderer WidgetRenderer) *Skeleton {
+	s.widget.SetRenderer(renderer)
+	s.updater.Update()
+	return s
+}
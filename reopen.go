@@ -0,0 +1,81 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// defaultClosedPagesLimit is the default number of closed pages kept to reopen.
+const defaultClosedPagesLimit = 10
+
+// closedPage is hold the required fields to reopen a closed page.
+type closedPage struct {
+	key   string
+	title string
+	page  tea.Model
+}
+
+// pushClosedPage adds the given page to the closed pages stack.
+func (s *Skeleton) pushClosedPage(hdr commonHeader, page tea.Model) {
+	if s.closedPagesLimit <= 0 {
+		return
+	}
+
+	s.closedPages = append(s.closedPages, closedPage{
+		key:   hdr.key,
+		title: hdr.title,
+		page:  page,
+	})
+	if len(s.closedPages) > s.closedPagesLimit {
+		s.closedPages = s.closedPages[len(s.closedPages)-s.closedPagesLimit:]
+	}
+}
+
+// ReopenClosedPage restores the most recently closed page and makes it active.
+// It returns false if there is no closed page to reopen, or if the page can not be added, e.g. at the
+// page limit (see SetMaxPages). The page which can not be added is kept to reopen later.
+func (s *Skeleton) ReopenClosedPage() bool {
+	for len(s.closedPages) > 0 {
+		last := s.closedPages[len(s.closedPages)-1]
+		s.closedPages = s.closedPages[:len(s.closedPages)-1]
+
+		// skip the page if another page took its key meanwhile
+		if s.hasPage(last.key) {
+			continue
+		}
+
+		s.AddPage(last.key, last.title, last.page)
+		if !s.hasPage(last.key) {
+			s.closedPages = append(s.closedPages, last)
+			return false
+		}
+		s.SetActivePage(last.key)
+		return true
+	}
+	return false
+}
+
+// GetClosedPageKeys returns the keys of the recently closed pages, the most recent one is the first.
+func (s *Skeleton) GetClosedPageKeys() []string {
+	keys := make([]string, 0, len(s.closedPages))
+	for i := len(s.closedPages) - 1; i >= 0; i-- {
+		keys = append(keys, s.closedPages[i].key)
+	}
+	return keys
+}
+
+// SetClosedPagesLimit sets the maximum number of closed pages kept to reopen. Zero disables reopening.
+func (s *Skeleton) SetClosedPagesLimit(limit int) *Skeleton {
+	if limit < 0 {
+		limit = 0
+	}
+	s.closedPagesLimit = limit
+	if len(s.closedPages) > limit {
+		s.closedPages = s.closedPages[len(s.closedPages)-limit:]
+	}
+	return s
+}
+
+// hasPage returns true if a page with the given key exists.
+func (s *Skeleton) hasPage(key string) bool {
+	return s.pageIndex(key) >= 0
+}
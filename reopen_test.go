@@ -0,0 +1,27 @@
+package skeleton
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestReopenClosedPageAtPageLimit(t *testing.T) {
+	s := NewSkeleton()
+	s.AddPage("first", "First", newTestPage())
+	s.AddPage("second", "Second", newTestPage())
+	s.AddPage("third", "Third", newTestPage())
+	updateSync(s, DeletePageMsg{Key: "third"})
+	s.SetMaxPages(2, RejectNew)
+
+	if s.ReopenClosedPage() {
+		t.Error("ReopenClosedPage reported a page which is not added")
+	}
+	if got := s.GetClosedPageKeys(); !slices.Equal(got, []string{"third"}) {
+		t.Errorf("closed pages are %v, want [third]", got)
+	}
+
+	s.SetMaxPages(0, RejectNew)
+	if !s.ReopenClosedPage() || !s.hasPage("third") {
+		t.Error("the kept page is not reopened once there is room")
+	}
+}
@@ -0,0 +1,36 @@
+package skeleton
+
+// MovePageLeft moves the active tab one position to the left, past the tabs hidden by the workspace.
+// It returns false if the tab is already the first one.
+func (s *Skeleton) MovePageLeft() bool {
+	return s.movePage(-1)
+}
+
+// MovePageRight moves the active tab one position to the right, past the tabs hidden by the workspace.
+// It returns false if the tab is already the last one.
+func (s *Skeleton) MovePageRight() bool {
+	return s.movePage(1)
+}
+
+// movePage swaps the active tab with the next visible tab in the given direction.
+func (s *Skeleton) movePage(direction int) bool {
+	from := s.currentTab
+	if from < 0 || from >= len(s.pages) {
+		return false
+	}
+
+	to := from + direction
+	for to >= 0 && to < len(s.pages) && !s.header.isVisible(to) {
+		to += direction
+	}
+	if to < 0 || to >= len(s.pages) {
+		return false
+	}
+
+	s.pages[from], s.pages[to] = s.pages[to], s.pages[from]
+	s.header.headers[from], s.header.headers[to] = s.header.headers[to], s.header.headers[from]
+	s.currentTab = to
+	s.header.SetCurrentTab(to)
+	s.updater.Update()
+	return true
+}
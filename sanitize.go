@@ -0,0 +1,76 @@
+package skeleton
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// SanitizeANSI removes the escape sequences and the control characters which could move the cursor, clear
+// the screen, change the terminal state or spoof links from untrusted content, e.g. the data of an RSS feed.
+// The printable text, the newlines, the tabs and the styles (SGR sequences) are kept.
+func SanitizeANSI(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	var state byte
+	for len(s) > 0 {
+		seq, _, n, newState := ansi.DecodeSequence(s, state, nil)
+		state = newState
+		if isSafeSequence(seq) {
+			b.WriteString(seq)
+		}
+		s = s[n:]
+	}
+	return b.String()
+}
+
+// isSafeSequence returns true if the decoded sequence is printable text, a newline, a tab or a style.
+func isSafeSequence(seq string) bool {
+	switch {
+	case seq == "\n" || seq == "\t":
+		return true
+	case ansi.HasCsiPrefix(seq):
+		return isStyleSequence(seq)
+	}
+	r, _ := utf8.DecodeRuneInString(seq)
+	return !unicode.IsControl(r)
+}
+
+// isStyleSequence returns true if the control sequence only sets the graphic rendition, e.g. "\x1b[1;31m".
+func isStyleSequence(seq string) bool {
+	params := strings.TrimPrefix(strings.TrimPrefix(seq, "\x1b["), "\x9b")
+	if !strings.HasSuffix(params, "m") {
+		return false
+	}
+	return strings.Trim(strings.TrimSuffix(params, "m"), "0123456789;:") == ""
+}
+
+// SanitizeProcessor returns a processor which removes the dangerous escape sequences but keeps the styles,
+// see SanitizeANSI. Unlike StripANSIProcessor, the colors of the content are kept.
+func SanitizeProcessor() ViewProcessor {
+	return SanitizeANSI
+}
+
+// SetSanitizeWidgets sanitizes the values of the widgets with SanitizeANSI when they are set, e.g. when
+// they show the data of network sources. It applies to the values set after it is enabled.
+func (s *Skeleton) SetSanitizeWidgets(sanitize bool) *Skeleton {
+	s.widget.sanitize = sanitize
+	s.updater.Update()
+	return s
+}
+
+// GetSanitizeWidgets returns the values of the widgets are sanitized or not.
+func (s *Skeleton) GetSanitizeWidgets() bool {
+	return s.widget.sanitize
+}
+
+// sanitizeValue returns the value sanitized if the widgets are sanitized.
+func (w *widget) sanitizeValue(value string) string {
+	if !w.sanitize {
+		return value
+	}
+	return SanitizeANSI(value)
+}
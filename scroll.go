@@ -0,0 +1,206 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// StickySide is the edge of the scrolling header which a sticky tab is pinned to.
+type StickySide int
+
+const (
+	// StickyNone scrolls the tab with the others.
+	StickyNone StickySide = iota
+	// StickyLeft pins the tab to the left edge of the header.
+	StickyLeft
+	// StickyRight pins the tab to the right edge of the header.
+	StickyRight
+)
+
+// scrollLayout is hold the indexes of the tabs rendered while scrolling.
+type scrollLayout struct {
+	left   []int
+	window []int
+	right  []int
+
+	// hiddenBefore and hiddenAfter report there are scrolled out tabs on that side
+	hiddenBefore bool
+	hiddenAfter  bool
+}
+
+// isScrolling returns true if the header is scrollable and the tabs do not fit.
+func (h *header) isScrolling() bool {
+	return h.properties.scrollable && !h.isSidebar() && !h.isPaginated() && h.titleLength+2 > h.viewport.Width
+}
+
+// tabWidth returns the rendered width of the tab at the given index.
+func (h *header) tabWidth(i int) int {
+	return h.titleWidth(h.headers[i]) + h.properties.leftTabPadding + h.properties.rightTabPadding + 2
+}
+
+// indexesWidth returns the rendered width of the tabs by the given indexes.
+func (h *header) indexesWidth(indexes []int) int {
+	var width int
+	for _, i := range indexes {
+		width += h.tabWidth(i)
+	}
+	return width
+}
+
+// scrollIndicatorsWidth returns the width of the scroll indicators on both sides.
+func (h *header) scrollIndicatorsWidth() int {
+	return 2
+}
+
+// scrollIndicator renders the scroll indicator, it is blank if there are no hidden tabs in its direction.
+func (h *header) scrollIndicator(visible bool, glyph string) string {
+	if !visible {
+		glyph = " "
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(h.properties.borderColor)).Render(glyph)
+}
+
+// layoutWidth returns the rendered width of the given scroll layout with the indicators.
+func (h *header) layoutWidth(layout scrollLayout) int {
+	width := h.indexesWidth(layout.left) + h.indexesWidth(layout.window) + h.indexesWidth(layout.right) + h.scrollIndicatorsWidth()
+	if h.newTabButton {
+		width += h.newTabButtonWidth()
+	}
+	return width
+}
+
+// scrollLayout returns the tabs which fit into the header. The sticky tabs are always shown at
+// their edges and the window of the other tabs always contains the active tab.
+func (h *header) scrollLayout() scrollLayout {
+	var layout scrollLayout
+	var candidates []int
+	for i, hdr := range h.headers {
+		if !h.isVisible(i) {
+			continue
+		}
+		switch h.stickyTabs[hdr.key] {
+		case StickyLeft:
+			layout.left = append(layout.left, i)
+		case StickyRight:
+			layout.right = append(layout.right, i)
+		default:
+			candidates = append(candidates, i)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return layout
+	}
+
+	// for the corners, indicators, sticky tabs and at least one line
+	available := h.viewport.Width - 2 - h.scrollIndicatorsWidth() - 1 - h.indexesWidth(layout.left) - h.indexesWidth(layout.right)
+	if h.newTabButton {
+		available -= h.newTabButtonWidth()
+	}
+
+	active := -1
+	for pos, i := range candidates {
+		if i == h.currentTab {
+			active = pos
+		}
+	}
+
+	var start, end int
+	if h.properties.centerActiveTab && active >= 0 {
+		start, end = h.centeredTabRange(candidates, active, available)
+	} else {
+		start, end = h.shiftedTabRange(candidates, active, available)
+	}
+
+	h.scrollOffset = start
+	layout.window = candidates[start:end]
+	layout.hiddenBefore = start > 0
+	layout.hiddenAfter = end < len(candidates)
+	return layout
+}
+
+// centeredTabRange grows the range around the active tab alternately to both sides while it fits.
+func (h *header) centeredTabRange(candidates []int, active, available int) (int, int) {
+	start, end := active, active+1
+	width := h.tabWidth(candidates[active])
+
+	for {
+		grown := false
+		if start > 0 && width+h.tabWidth(candidates[start-1]) <= available {
+			start--
+			width += h.tabWidth(candidates[start])
+			grown = true
+		}
+		if end < len(candidates) && width+h.tabWidth(candidates[end]) <= available {
+			width += h.tabWidth(candidates[end])
+			end++
+			grown = true
+		}
+		if !grown {
+			return start, end
+		}
+	}
+}
+
+// shiftedTabRange keeps the previous window and shifts it only as much as needed to show the active tab.
+// If the active tab is sticky (active is -1), the previous window is kept.
+func (h *header) shiftedTabRange(candidates []int, active, available int) (int, int) {
+	start := min(max(h.scrollOffset, 0), len(candidates)-1)
+	if active >= 0 {
+		start = min(start, active)
+
+		// shift the window right until the active tab fits
+		for start < active && h.indexesWidth(candidates[start:active+1]) > available {
+			start++
+		}
+	}
+
+	end := start
+	width := 0
+	for end < len(candidates) && width+h.tabWidth(candidates[end]) <= available {
+		width += h.tabWidth(candidates[end])
+		end++
+	}
+
+	// the active tab is always shown, even if it is wider than the header
+	if end <= active {
+		end = active + 1
+	}
+	return start, end
+}
+
+// SetScrollableTabs enables scrolling the tabs when they do not fit into the header,
+// instead of reporting that the terminal is too small.
+func (s *Skeleton) SetScrollableTabs(scrollable bool) *Skeleton {
+	s.header.properties.scrollable = scrollable
+	s.header.calculateTitleLength()
+	s.updater.Update()
+	return s
+}
+
+// SetCenterActiveTab keeps the active tab centered while the tabs are scrolled. When disabled,
+// the visible tabs are shifted only as much as needed to keep the active tab fully visible.
+func (s *Skeleton) SetCenterActiveTab(center bool) *Skeleton {
+	s.header.properties.centerActiveTab = center
+	s.updater.Update()
+	return s
+}
+
+// SetTabSticky pins the tab by the given key to an edge of the scrolling header,
+// so it stays visible regardless of the scroll position. StickyNone unpins it.
+func (s *Skeleton) SetTabSticky(key string, side StickySide) *Skeleton {
+	key = s.normalizeKey(key)
+	if side == StickyNone {
+		delete(s.header.stickyTabs, key)
+	} else {
+		s.header.stickyTabs[key] = side
+	}
+	s.header.calculateTitleLength()
+	s.updater.Update()
+	return s
+}
+
+// GetTabSticky returns the edge which the tab by the given key is pinned to.
+func (s *Skeleton) GetTabSticky(key string) StickySide {
+	key = s.normalizeKey(key)
+	return s.header.stickyTabs[key]
+}
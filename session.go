@@ -0,0 +1,76 @@
+package skeleton
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Session is the state of the Skeleton which is persisted between runs: the active page, the
+// active workspace and the layout. The pages themselves are created by the application.
+type Session struct {
+	ActivePage string `json:"activePage,omitempty"`
+	Workspace  string `json:"workspace,omitempty"`
+	Layout     Layout `json:"layout"`
+}
+
+// ExportSession returns the current session of the Skeleton.
+func (s *Skeleton) ExportSession() Session {
+	return Session{
+		ActivePage: s.GetActivePage(),
+		Workspace:  s.GetActiveWorkspace(),
+		Layout:     s.ExportLayout(),
+	}
+}
+
+// ApplySession restores the given session. Pages which do not exist anymore are ignored.
+func (s *Skeleton) ApplySession(session Session) *Skeleton {
+	s.ApplyLayout(session.Layout)
+	s.SetActiveWorkspace(session.Workspace)
+	if session.ActivePage != "" {
+		s.SetActivePage(session.ActivePage)
+	}
+	return s
+}
+
+// SaveSession writes the current session to the file by the given path as JSON.
+func (s *Skeleton) SaveSession(path string) error {
+	data, err := json.MarshalIndent(s.ExportSession(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("skeleton: encode session: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("skeleton: save session: %w", err)
+	}
+	return nil
+}
+
+// LoadSession restores the session from the file by the given path, see ApplySession.
+func (s *Skeleton) LoadSession(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("skeleton: load session: %w", err)
+	}
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return fmt.Errorf("skeleton: decode session: %w", err)
+	}
+	s.ApplySession(session)
+	return nil
+}
+
+// SetSessionFile sets the file the session is saved to when the application shuts down, see OnShutdown.
+// An empty path disables saving.
+func (s *Skeleton) SetSessionFile(path string) *Skeleton {
+	s.shutdown.mu.Lock()
+	s.shutdown.sessionFile = path
+	s.shutdown.mu.Unlock()
+	return s
+}
+
+// GetSessionFile returns the file the session is saved to when the application shuts down.
+func (s *Skeleton) GetSessionFile() string {
+	s.shutdown.mu.Lock()
+	defer s.shutdown.mu.Unlock()
+	return s.shutdown.sessionFile
+}
@@ -0,0 +1,212 @@
+package skeleton
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TabLayoutMode is the way the tabs are rendered.
+type TabLayoutMode int
+
+const (
+	// TabsHorizontal renders the tabs as a horizontal strip in the header.
+	TabsHorizontal TabLayoutMode = iota
+	// TabsSidebar renders the tabs as a vertical list on the left side of the body.
+	TabsSidebar
+)
+
+// TabOrientation is the direction the tabs are listed in, it is a shorthand for the tab layout.
+type TabOrientation int
+
+const (
+	// Horizontal lists the tabs in the header, it is the TabsHorizontal layout.
+	Horizontal TabOrientation = iota
+	// Vertical lists the tabs in a column on the left side of the body, like the tool windows of an IDE.
+	// It is the TabsSidebar layout.
+	Vertical
+)
+
+// defaultSidebarWidth is the width of the sidebar, without its separator.
+const defaultSidebarWidth = 20
+
+// minSidebarWidth is the smallest usable width of the sidebar.
+const minSidebarWidth = 4
+
+// isSidebar returns true if the tabs are rendered as a sidebar. A custom renderer always renders the header,
+// and the sidebar is hidden with the header.
+func (h *header) isSidebar() bool {
+	return h.properties.tabLayout == TabsSidebar && h.renderer == nil && !h.hidden
+}
+
+// sidebarWidth returns the width of the sidebar, it never takes more than half of the terminal.
+func (h *header) sidebarWidth() int {
+	return max(min(h.properties.sidebarWidth, (h.viewport.Width-2)/2), 0)
+}
+
+// sidebarTabs returns the indexes of the tabs listed in the sidebar.
+func (h *header) sidebarTabs() []int {
+	var tabs []int
+	for i := range h.headers {
+		if h.isVisible(i) {
+			tabs = append(tabs, i)
+		}
+	}
+	return tabs
+}
+
+// sidebarWindow returns the range of the listed tabs which fit into the given height.
+// The previous window is kept and shifted only as much as needed to show the active tab.
+func (h *header) sidebarWindow(tabs []int, height int) (int, int) {
+	if height <= 0 || len(tabs) == 0 {
+		return 0, 0
+	}
+
+	start := min(max(h.sidebarOffset, 0), max(len(tabs)-height, 0))
+	for pos, i := range tabs {
+		if i != h.currentTab {
+			continue
+		}
+		if pos < start {
+			start = pos
+		}
+		if pos >= start+height {
+			start = pos - height + 1
+		}
+	}
+
+	h.sidebarOffset = start
+	return start, min(start+height, len(tabs))
+}
+
+// sidebarView renders the sidebar with the given height, the active tab is always visible.
+func (h *header) sidebarView(height int) string {
+	width := h.sidebarWidth()
+	tabs := h.sidebarTabs()
+	start, end := h.sidebarWindow(tabs, height)
+
+	lines := make([]string, 0, height)
+	for _, i := range tabs[start:end] {
+		lines = append(lines, h.renderSidebarTab(i, width))
+	}
+	for len(lines) < height {
+		lines = append(lines, strings.Repeat(" ", width))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// renderSidebarTab renders the tab at the given index as a line of the sidebar.
+func (h *header) renderSidebarTab(i, width int) string {
+	hdr := h.headers[i]
+	label := truncateText(h.tabLabel(hdr), max(width-2, 0))
+	style := lipgloss.NewStyle().Width(width).MaxWidth(width).PaddingLeft(1)
+
+	switch {
+	case i == h.currentTab:
+		color := h.properties.titleStyleActive.GetBorderTopForeground()
+		if tabColor, ok := h.tabColors[hdr.key]; ok && tabColor.active != "" {
+			color = lipgloss.Color(tabColor.active)
+		}
+		return style.Bold(true).Reverse(true).Foreground(color).Render(label)
+	case h.isTabUnavailable(hdr.key):
+		return style.Foreground(h.properties.titleStyleUnavailable.GetForeground()).Strikethrough(true).Render(label)
+	case h.GetLockTabs() || h.IsTabLocked(hdr.key):
+		return style.Foreground(lipgloss.Color("240")).Faint(true).Render(label)
+	default:
+		if tabColor, ok := h.tabColors[hdr.key]; ok && tabColor.inactive != "" {
+			style = style.Foreground(lipgloss.Color(tabColor.inactive))
+		}
+		return style.Render(label)
+	}
+}
+
+// sidebarTabAt returns the index of the tab listed on the given row of the sidebar.
+func (h *header) sidebarTabAt(row int) (int, bool) {
+	tabs := h.sidebarTabs()
+	pos := h.sidebarOffset + row
+	if row < 0 || pos >= len(tabs) {
+		return 0, false
+	}
+	return tabs[pos], true
+}
+
+// withSidebar joins the sidebar and its separator to the left of the given page content.
+func (s *Skeleton) withSidebar(body string, height int) string {
+	separator := strings.TrimSuffix(strings.Repeat(s.properties.glyphs.Frame.Left+"\n", height), "\n")
+	separator = lipgloss.NewStyle().Foreground(lipgloss.Color(s.properties.borderColor)).Render(separator)
+	page := lipgloss.NewStyle().
+		Width(max(s.GetContentWidth(), 0)).
+		Align(s.activePageAlignment()).
+		Render(body)
+	return lipgloss.JoinHorizontal(lipgloss.Top, s.header.sidebarView(height), separator, page)
+}
+
+// handleSidebarMouse handles the mouse events of the sidebar, clicks jump to the tab on that row.
+func (s *Skeleton) handleSidebarMouse(msg tea.MouseMsg) (tea.Cmd, bool) {
+	if msg.Action != tea.MouseActionPress {
+		return nil, true
+	}
+
+	switch msg.Button {
+	case tea.MouseButtonWheelUp:
+		return tea.Batch(s.switchPage(nil, "left")...), true
+	case tea.MouseButtonWheelDown:
+		return tea.Batch(s.switchPage(nil, "right")...), true
+	case tea.MouseButtonLeft:
+		index, ok := s.header.sidebarTabAt(msg.Y - s.bodyTop())
+		if !ok {
+			return nil, true
+		}
+		s.showBlockedReason(index)
+		var cmds []tea.Cmd
+		if s.JumpToTab(index) {
+			cmds = append(cmds, s.IAMActivePageCmd())
+		}
+		cmds = append(cmds, s.registerTabClick(index))
+		return tea.Batch(cmds...), true
+	}
+	return nil, true
+}
+
+// SetTabLayout sets the way the tabs are rendered. TabsSidebar lists the tabs on the left side
+// of the body, which scales better than the horizontal strip for applications with many pages.
+func (s *Skeleton) SetTabLayout(mode TabLayoutMode) *Skeleton {
+	s.header.properties.tabLayout = mode
+	s.updater.UpdateWithMsg(s.header.calculateTitleLength()())
+	return s
+}
+
+// GetTabLayout returns the way the tabs are rendered.
+func (s *Skeleton) GetTabLayout() TabLayoutMode {
+	return s.header.properties.tabLayout
+}
+
+// SetTabOrientation sets the direction the tabs are listed in. Vertical is the same as SetTabLayout(TabsSidebar),
+// the pages are managed the same way in both orientations.
+func (s *Skeleton) SetTabOrientation(orientation TabOrientation) *Skeleton {
+	if orientation == Vertical {
+		return s.SetTabLayout(TabsSidebar)
+	}
+	return s.SetTabLayout(TabsHorizontal)
+}
+
+// GetTabOrientation returns the direction the tabs are listed in.
+func (s *Skeleton) GetTabOrientation() TabOrientation {
+	if s.GetTabLayout() == TabsSidebar {
+		return Vertical
+	}
+	return Horizontal
+}
+
+// SetSidebarWidth sets the width of the sidebar, it is used when the tab layout is TabsSidebar.
+func (s *Skeleton) SetSidebarWidth(width int) *Skeleton {
+	s.header.properties.sidebarWidth = max(width, minSidebarWidth)
+	s.updater.Update()
+	return s
+}
+
+// GetSidebarWidth returns the width of the sidebar.
+func (s *Skeleton) GetSidebarWidth() int {
+	return s.header.properties.sidebarWidth
+}
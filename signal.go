@@ -0,0 +1,110 @@
+package skeleton
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+)
+
+// shutdownSignals are the signals which shut the application down gracefully.
+var shutdownSignals = []os.Signal{syscall.SIGTERM, syscall.SIGHUP}
+
+// shutdownState is hold the cleanup hooks and the session file, which are run and saved once on shutdown.
+type shutdownState struct {
+	mu          sync.Mutex
+	hooks       []func()
+	sessionFile string
+	once        sync.Once
+}
+
+// OnShutdown registers a cleanup hook which is called once when the application shuts down by
+// SIGTERM or SIGHUP, or when Run returns. The hooks are called in the order they are registered,
+// after the session is saved and before the context of the Skeleton is cancelled.
+func (s *Skeleton) OnShutdown(hook func()) *Skeleton {
+	if hook == nil {
+		return s
+	}
+	s.shutdown.mu.Lock()
+	s.shutdown.hooks = append(s.shutdown.hooks, hook)
+	s.shutdown.mu.Unlock()
+	return s
+}
+
+// HandleSignals listens to the signals of the process until the returned function is called, it is
+// called by Run. SIGTERM and SIGHUP quit the application, Run saves the session and runs the cleanup
+// hooks after the program stopped, so they do not race with the update loop.
+// Resize signals are delivered as tea.WindowSizeMsg on the platforms which have them, so the size is
+// updated even when the program can not read it from its output.
+func (s *Skeleton) HandleSignals() (stop func()) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, append(shutdownSignals, resizeSignals...)...)
+	done := make(chan struct{})
+
+	go func() {
+		defer s.restoreOnPanic()
+		for {
+			select {
+			case sig := <-signals:
+				if isResizeSignal(sig) {
+					if size, ok := terminalWindowSize(); ok {
+						s.updater.UpdateWithMsg(resizeMsg{size: size})
+					}
+					continue
+				}
+				s.quitProgram()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			signal.Stop(signals)
+			close(done)
+		})
+	}
+}
+
+// quitProgram asks the program to quit. Unlike Quit, the request is not dropped when the updater is busy.
+func (s *Skeleton) quitProgram() {
+	s.programMu.Lock()
+	p := s.program
+	s.programMu.Unlock()
+
+	if p == nil {
+		s.Quit()
+		return
+	}
+	p.Quit()
+}
+
+// Shutdown saves the session, runs the cleanup hooks and cancels the context of the Skeleton, which stops
+// its goroutines, e.g. the ones of Go, Every and the tickers. Run calls it when the program quits, the
+// applications which run the Skeleton in a program of their own call it after the program returned.
+// Only the first call does it.
+func (s *Skeleton) Shutdown() {
+	s.runShutdown()
+}
+
+// runShutdown saves the session, runs the cleanup hooks and cancels the context of the Skeleton,
+// only the first call does it.
+// Concurrent calls wait until it is done.
+func (s *Skeleton) runShutdown() {
+	s.shutdown.once.Do(func() {
+		s.shutdown.mu.Lock()
+		hooks := append([]func(){}, s.shutdown.hooks...)
+		sessionFile := s.shutdown.sessionFile
+		s.shutdown.mu.Unlock()
+
+		if sessionFile != "" {
+			_ = s.SaveSession(sessionFile)
+		}
+		for _, hook := range hooks {
+			hook()
+		}
+		s.cancel()
+	})
+}
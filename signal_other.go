@@ -0,0 +1,23 @@
+//go:build !unix
+
+package skeleton
+
+import (
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// resizeSignals are the signals which report a terminal resize, there are none on this platform
+// and the program reads the resizes from the console.
+var resizeSignals []os.Signal
+
+// isResizeSignal returns true if the given signal reports a terminal resize.
+func isResizeSignal(os.Signal) bool {
+	return false
+}
+
+// terminalWindowSize reads the terminal size, it is not supported on this platform.
+func terminalWindowSize() (tea.WindowSizeMsg, bool) {
+	return tea.WindowSizeMsg{}, false
+}
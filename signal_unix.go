@@ -0,0 +1,30 @@
+//go:build unix
+
+package skeleton
+
+import (
+	"os"
+	"syscall"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/term"
+)
+
+// resizeSignals are the signals which report a terminal resize.
+var resizeSignals = []os.Signal{syscall.SIGWINCH}
+
+// isResizeSignal returns true if the given signal reports a terminal resize.
+func isResizeSignal(sig os.Signal) bool {
+	return sig == syscall.SIGWINCH
+}
+
+// terminalWindowSize reads the terminal size from the standard output, or from the standard input
+// if the output is redirected.
+func terminalWindowSize() (tea.WindowSizeMsg, bool) {
+	for _, f := range []*os.File{os.Stdout, os.Stdin} {
+		if width, height, err := term.GetSize(f.Fd()); err == nil {
+			return tea.WindowSizeMsg{Width: width, Height: height}, true
+		}
+	}
+	return tea.WindowSizeMsg{}, false
+}
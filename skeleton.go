@@ -1,8 +1,16 @@
 package skeleton
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
@@ -10,6 +18,10 @@ import (
 )
 
 // Skeleton is a helper for rendering the Skeleton of the terminal.
+//
+// The Skeleton is not safe for concurrent use, it is meant to be changed before Run and by the pages while
+// they handle their messages. Changing it from other goroutines is deprecated: deliver messages with Go,
+// Every or TriggerUpdateWithMsg instead, and use AddDynamicWidget for polling widgets.
 type Skeleton struct {
 	// termReady is control terminal is ready or not, it responsible for the terminal size
 	termReady bool
@@ -42,18 +54,177 @@ type Skeleton struct {
 	properties *skeletonProperties
 
 	updater *Updater
+
+	// throttler is responsible for rate limiting external updates
+	throttler *throttler
+
+	// dirtyPages holds the keys of pages which have unsaved changes
+	dirtyPages map[string]bool
+
+	// pendingClose is hold the key of the dirty page waiting for close confirmation
+	pendingClose string
+
+	// modals are hold the queued modal dialogs, the first one is shown
+	modals []*modal
+
+	// timers are hold the running timers of the pages
+	timers *pageTimers
+
+	// watchers are hold the watched files
+	watchers *fileWatchers
+
+	// program is hold the program which runs the Skeleton, it restores the terminal on panics
+	program   *tea.Program
+	programMu sync.Mutex
+
+	// output is hold the output of the program, the terminal sequences are written to it
+	output io.Writer
+
+	// frameCapture is hold the subscribers of the composed frames
+	frameCapture frameCapture
+
+	// mirrorCount is hold the number of attached mirrors
+	mirrorCount atomic.Int32
+
+	// shutdown is hold the cleanup hooks and the session file of the graceful shutdown
+	shutdown shutdownState
+
+	// themes are hold the registered themes, currentTheme is the index of the applied one
+	themes       []Theme
+	currentTheme int
+
+	// toasts are hold the shown toast notifications, the newest one is the last
+	toasts      []toast
+	lastToastID int
+	toastMu     sync.Mutex
+
+	// statusMessage is hold the id state of the footer status messages, statusMessageID is the shown one
+	statusMessage   statusMessageState
+	statusMessageID int
+
+	// pageKeyMaps are hold the key maps registered by the pages, they are shown in the help overlay
+	pageKeyMaps map[string]help.KeyMap
+
+	// pageHotkeys are hold the keys of the pages which are activated by them, see BindKeyToPage
+	pageHotkeys map[string]string
+
+	// permissions are hold the capabilities of the session
+	permissions Permissions
+
+	// interactionSink receives the recorded interactions, nothing is recorded if it is nil
+	interactionSink InteractionSink
+
+	// clock is the source of time of the timers, the expiring messages and the animated widgets
+	clock Clock
+
+	// ctx is cancelled by cancel when the application shuts down, it stops the goroutines started by Go and Every
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// widgetsTicking is control the next tick of the spinner and the clock widgets is scheduled or not
+	widgetsTicking bool
+
+	// helpVisible is control the help overlay is shown or not
+	helpVisible bool
+
+	// newTabHandler is called when the user asks for a new tab
+	newTabHandler NewTabHandler
+
+	// tabDoubleClickAction is invoked when a tab title is double-clicked
+	tabDoubleClickAction TabAction
+	lastTabClick         lastClick
+
+	// onQuitRequested is called before the application quits by the quit key
+	onQuitRequested QuitRequestHandler
+
+	// terminalSize is hold the last size reported by the terminal, the viewport may be smaller in the inline mode
+	terminalSize Size
+
+	// focusedRegion is hold the region which has the keyboard focus, empty is the body
+	focusedRegion RenderRegion
+
+	// onCloseRequested is called before a tab is closed by its close glyph, it can veto closing
+	onCloseRequested CloseRequestHandler
+
+	// quitKeyDisabled delivers the quit key to the pages instead of quitting
+	quitKeyDisabled bool
+
+	// announcer is hold the announcements of the screen-reader mode
+	announcer announcer
+
+	// frameTimer is hold the frame budget and the timing of the last frame
+	frameTimer frameTimer
+
+	// profiler is hold the pprof server and the timing counters of the modules
+	profiler profiler
+
+	// messageLog is hold the types of the recent messages for the support bundle
+	messageLog messageLog
+
+	// workspaceTabs are hold the last active tab keys of the workspaces
+	workspaceTabs map[string]string
+
+	// viewProcessors are applied to the output of every page, pageViewProcessors to the page by the key
+	viewProcessors     []ViewProcessor
+	pageViewProcessors map[string][]ViewProcessor
+
+	// pageAlignments are hold the positions of the page contents which override the page position, by their keys
+	pageAlignments map[string]lipgloss.Position
+
+	// pageWordWrap is hold the keys of the pages whose output is reflowed to the content width
+	pageWordWrap map[string]bool
+
+	// closedPages is hold the recently closed pages, the most recent one is the last
+	closedPages []closedPage
+
+	// closedPagesLimit is hold the maximum number of closed pages kept to reopen
+	closedPagesLimit int
+
+	// navigation is hold the back/forward history of the activated tabs
+	navigation *navigationHistory
+
+	// pageLimit is hold the maximum number of the open pages and the recency of the pages
+	pageLimit *pageLimit
 }
 
 // NewSkeleton returns a new Skeleton.
 func NewSkeleton() *Skeleton {
-	return &Skeleton{
+	// the header and the widget share the state of their Skeleton, nothing is shared between the Skeletons
+	vp := newTerminalViewport()
+	keyMap := newKeyMap()
+	updater := NewUpdater()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &Skeleton{
 		properties: defaultSkeletonProperties(),
-		viewport:   newTerminalViewport(),
-		header:     newHeader(),
-		widget:     newWidget(),
-		KeyMap:     newKeyMap(),
-		updater:    NewUpdater(),
+		viewport:   vp,
+		header:     newHeader(vp, keyMap, updater),
+		widget:     newWidget(vp, updater),
+		KeyMap:     keyMap,
+		updater:    updater,
+		throttler:  newThrottler(),
+		dirtyPages: make(map[string]bool),
+		clock:      SystemClock(),
+		ctx:        ctx,
+		cancel:     cancel,
+
+		closedPagesLimit: defaultClosedPagesLimit,
+		navigation:       newNavigationHistory(),
+		pageLimit:        newPageLimit(),
+		timers:           newPageTimers(),
+		watchers:         newFileWatchers(),
+		currentTheme:     -1,
+		pageKeyMaps:      make(map[string]help.KeyMap),
+		pageHotkeys:      make(map[string]string),
+		workspaceTabs:    make(map[string]string),
+
+		pageViewProcessors: make(map[string][]ViewProcessor),
+		pageAlignments:     make(map[string]lipgloss.Position),
+		pageWordWrap:       make(map[string]bool),
 	}
+	s.widget.activePage = s.activeWidgetPage
+	s.widget.pageFooter = s.activePageFooter
+	return s
 }
 
 // skeletonProperties are hold the properties of the Skeleton.
@@ -61,21 +232,63 @@ type skeletonProperties struct {
 	borderColor  string
 	pagePosition lipgloss.Position
 	wrapTabs     bool
+
+	// glyphs is the glyph set in use, requestedGlyphs is the one set by the user before the fallback
+	glyphs          GlyphSet
+	requestedGlyphs GlyphSet
+	glyphSupport    GlyphSupport
+
+	// mouseEnabled is control the mouse events are enabled or not
+	mouseEnabled bool
+
+	// widgetKeyPolicy decides what happens when a widget is added with a key which already exists
+	widgetKeyPolicy WidgetKeyPolicy
+
+	// normalizeKeys trims and lowercases the page and widget keys, and rejects control characters
+	normalizeKeys bool
+
+	// headerPosition is the edge which the header is placed at, the widgets are placed at the other one
+	headerPosition HeaderPosition
+
+	// focusFollowsMouse moves the keyboard focus to the region under the mouse cursor
+	focusFollowsMouse bool
+
+	// multiplexer overrides the detected terminal multiplexer, see SetMultiplexer
+	multiplexer Multiplexer
+
+	// windowsCompatibility controls the workarounds for the Windows consoles
+	windowsCompatibility WindowsCompatibility
+
+	// inline renders the Skeleton within the normal terminal scrollback instead of the alternate screen
+	inline bool
+
+	// inlineHeight is the fixed height of the inline mode
+	inlineHeight int
 }
 
 // defaultSkeletonProperties returns the default properties of the Skeleton.
 func defaultSkeletonProperties() *skeletonProperties {
 	return &skeletonProperties{
-		borderColor:  "39",
-		pagePosition: lipgloss.Center,
-		wrapTabs:     false,
+		borderColor:     "39",
+		pagePosition:    lipgloss.Center,
+		wrapTabs:        false,
+		glyphs:          UnicodeGlyphs(),
+		requestedGlyphs: UnicodeGlyphs(),
+		glyphSupport:    GlyphSupportAuto,
+		inlineHeight:    defaultInlineHeight,
 	}
 }
 
+// TriggerUpdate triggers an update of the Skeleton, it does not block and is skipped when the buffer of the
+// updater (256 messages) is full, a pending update renders the changes anyway.
 func (s *Skeleton) TriggerUpdate() {
 	s.updater.Update()
 }
 
+// TriggerUpdateWithMsg sends the given message to the Skeleton and its active page. It does not block, so the
+// pages can call it while they handle a message, but the message is dropped when the buffer of the updater
+// (256 messages) is full. Deliver the messages which change state from other goroutines with Go or Every,
+// they wait for room instead.
 func (s *Skeleton) TriggerUpdateWithMsg(msg tea.Msg) {
 	s.updater.UpdateWithMsg(msg)
 }
@@ -113,6 +326,18 @@ func (s *Skeleton) SetWrapTabs(wrap bool) *Skeleton {
 	return s
 }
 
+// SetTabSwitchWraparound makes switching right on the last tab continue with the first one, and switching
+// left on the first tab with the last one. Locked, disabled and hidden tabs are skipped along the way.
+// It is the same option as SetWrapTabs.
+func (s *Skeleton) SetTabSwitchWraparound(wrap bool) *Skeleton {
+	return s.SetWrapTabs(wrap)
+}
+
+// IsTabSwitchWraparound returns switching the tabs wraps around at the ends or not.
+func (s *Skeleton) IsTabSwitchWraparound() bool {
+	return s.properties.wrapTabs
+}
+
 // GetPagePosition returns the position of the page.
 func (s *Skeleton) GetPagePosition() lipgloss.Position {
 	return s.properties.pagePosition
@@ -160,6 +385,89 @@ func (s *Skeleton) SetTabLeftPadding(padding int) *Skeleton {
 	return s
 }
 
+// SetTabMaxWidth sets the maximum width of the tab titles, longer titles are truncated with "…".
+// Zero removes the limit.
+func (s *Skeleton) SetTabMaxWidth(width int) *Skeleton {
+	s.header.SetTabMaxWidth(width)
+	s.updater.Update()
+	return s
+}
+
+// GetTabMaxWidth returns the maximum width of the tab titles.
+func (s *Skeleton) GetTabMaxWidth() int {
+	return s.header.properties.tabMaxWidth
+}
+
+// SetHeaderRightContent places the given content (e.g. the name of the application, a clock or the
+// connection status) flush-right on the header line. It is truncated to the room left by the tabs,
+// or omitted if there is none. An empty content removes it.
+func (s *Skeleton) SetHeaderRightContent(content string) *Skeleton {
+	s.header.rightContent = strings.ReplaceAll(content, "\n", " ")
+	s.updater.Update()
+	return s
+}
+
+// GetHeaderRightContent returns the content placed flush-right on the header line.
+func (s *Skeleton) GetHeaderRightContent() string {
+	return s.header.rightContent
+}
+
+// HideHeader removes the tab strip and gives the reclaimed rows to the page body.
+// The tabs can still be switched with the keys.
+func (s *Skeleton) HideHeader() *Skeleton {
+	return s.setHeaderHidden(true)
+}
+
+// ShowHeader shows the tab strip hidden by HideHeader.
+func (s *Skeleton) ShowHeader() *Skeleton {
+	return s.setHeaderHidden(false)
+}
+
+// ToggleHeader hides the tab strip if it is shown, otherwise it shows it.
+func (s *Skeleton) ToggleHeader() *Skeleton {
+	return s.setHeaderHidden(!s.header.hidden)
+}
+
+// IsHeaderHidden returns true if the tab strip is hidden.
+func (s *Skeleton) IsHeaderHidden() bool {
+	return s.header.hidden
+}
+
+// setHeaderHidden hides or shows the tab strip and reports whether the header fits again.
+func (s *Skeleton) setHeaderHidden(hidden bool) *Skeleton {
+	s.header.hidden = hidden
+	s.updater.UpdateWithMsg(s.header.calculateTitleLength()())
+	return s
+}
+
+// HideWidgets collapses the widget bar, including the top bar, to the bottom border of the frame and gives
+// the reclaimed rows to the page body. The widgets are still updated while they are hidden.
+func (s *Skeleton) HideWidgets() *Skeleton {
+	return s.setWidgetsHidden(true)
+}
+
+// ShowWidgets shows the widget bar hidden by HideWidgets.
+func (s *Skeleton) ShowWidgets() *Skeleton {
+	return s.setWidgetsHidden(false)
+}
+
+// ToggleWidgets hides the widget bar if it is shown, otherwise it shows it.
+func (s *Skeleton) ToggleWidgets() *Skeleton {
+	return s.setWidgetsHidden(!s.widget.hidden)
+}
+
+// IsWidgetsHidden returns true if the widget bar is hidden.
+func (s *Skeleton) IsWidgetsHidden() bool {
+	return s.widget.hidden
+}
+
+// setWidgetsHidden hides or shows the widget bar and reports whether the widgets fit again.
+func (s *Skeleton) setWidgetsHidden(hidden bool) *Skeleton {
+	s.widget.hidden = hidden
+	s.updater.UpdateWithMsg(s.widget.calculateWidgetLength()())
+	return s
+}
+
 // SetTabRightPadding sets the right padding of the Skeleton.
 func (s *Skeleton) SetTabRightPadding(padding int) *Skeleton {
 	s.header.SetRightPadding(padding)
@@ -220,8 +528,15 @@ type AddPageMsg struct {
 	Page tea.Model
 }
 
-// AddPage adds a new page to the Skeleton.
-func (s *Skeleton) AddPage(key string, title string, page tea.Model) *Skeleton {
+// AddPage adds a new page to the Skeleton. The optional description is shown on the footer line
+// while the page is active, see SetTabDescription. At the maximum number of pages the page limit
+// policy applies, see SetMaxPages.
+func (s *Skeleton) AddPage(key string, title string, page tea.Model, description ...string) *Skeleton {
+	key = s.normalizeKey(key)
+	if !s.validKey(key) {
+		return s
+	}
+
 	// do not add if key already exists
 	for _, hdr := range s.header.headers {
 		if hdr.key == key {
@@ -229,19 +544,29 @@ func (s *Skeleton) AddPage(key string, title string, page tea.Model) *Skeleton {
 		}
 	}
 
+	if !s.makeRoomForPage() {
+		return s
+	}
+
 	s.header.AddCommonHeader(key, title)
 	s.pages = append(s.pages, page)
+	s.pageLimit.view(key)
+	if len(description) > 0 && description[0] != "" {
+		s.header.tabDescriptions[key] = description[0]
+	}
 
 	s.updater.UpdateWithMsg(AddPageMsg{
 		Key:   key,
 		Title: title,
 		Page:  page,
 	})
+	s.recordInteraction(Interaction{Kind: InteractionPageOpened, Page: key})
 	return s
 }
 
 // UpdatePageTitle updates the title of the page by the given key.
 func (s *Skeleton) UpdatePageTitle(key string, title string) *Skeleton {
+	key = s.normalizeKey(key)
 	s.header.UpdateCommonHeader(key, title)
 	s.updater.Update()
 	return s
@@ -255,6 +580,7 @@ type DeletePageMsg struct {
 
 // DeletePage deletes the page by the given key.
 func (s *Skeleton) DeletePage(key string) *Skeleton {
+	key = s.normalizeKey(key)
 	s.updater.UpdateWithMsg(DeletePageMsg{Key: key})
 	return s
 }
@@ -271,30 +597,63 @@ func (s *Skeleton) deleteMsg(key string) {
 		s.header.SetCurrentTab(0)
 	}
 
+	// if the deleting tab is before the active tab, the active tab keeps its page
+	if index := s.pageIndex(key); index >= 0 && index < s.currentTab {
+		s.currentTab--
+		s.header.SetCurrentTab(s.currentTab)
+	}
+
 	var pages []tea.Model
 	for i := range s.pages {
 		if s.header.headers[i].key != key {
 			pages = append(pages, s.pages[i])
+		} else {
+			s.pushClosedPage(s.header.headers[i], s.pages[i])
 		}
 	}
 
 	s.header.DeleteCommonHeader(key)
 	s.pages = pages
+	delete(s.dirtyPages, key)
+	s.timers.cancel(key)
+	delete(s.pageKeyMaps, key)
+	delete(s.header.closableTabs, key)
+	delete(s.header.tabColors, key)
+	delete(s.header.tabIcons, key)
+	delete(s.header.disabledTabs, key)
+	delete(s.header.lockReasons, key)
+	delete(s.header.tabSuffixes, key)
+	delete(s.header.tabTemplates, key)
+	delete(s.header.tabDescriptions, key)
+	delete(s.header.tabSubtitles, key)
+	delete(s.header.tabBadges, key)
+	delete(s.header.tabWorkspaces, key)
+	delete(s.pageViewProcessors, key)
+	delete(s.pageAlignments, key)
+	delete(s.pageWordWrap, key)
+	delete(s.pageLimit.lastViewed, key)
+	s.header.hoveredClose = -1
+	s.deletePageWidgets(key)
+	s.widget.calculateWidgetLength()
+	s.recordInteraction(Interaction{Kind: InteractionPageClosed, Page: key})
 }
 
 // AddWidget adds a new widget to the Skeleton.
+// What happens to duplicate keys is decided by the widget key policy, see SetWidgetKeyPolicy.
 func (s *Skeleton) AddWidget(key string, value string) *Skeleton {
-	s.widget.addNewWidget(key, value)
-	s.updater.Update()
+	_, _ = s.AddWidgetE(key, value)
 	return s
 }
 
 // UpdateWidgetValue updates the Value content by the given key.
-// Adds the widget if it doesn't exist.
+// Adds the widget if it doesn't exist. The value replaces the formatting of the typed widgets, e.g. spinners.
 func (s *Skeleton) UpdateWidgetValue(key string, value string) *Skeleton {
+	key = s.normalizeKey(key)
 	// if widget not exists, add it
-	if s.widget.GetWidget(key) == nil {
+	if wgt := s.widget.GetWidget(key); wgt == nil {
 		s.AddWidget(key, value)
+	} else {
+		wgt.kind = nil
 	}
 	s.widget.updateWidgetContent(key, value)
 	s.updater.Update()
@@ -303,11 +662,37 @@ func (s *Skeleton) UpdateWidgetValue(key string, value string) *Skeleton {
 
 // DeleteWidget deletes the Value by the given key.
 func (s *Skeleton) DeleteWidget(key string) *Skeleton {
+	key = s.normalizeKey(key)
 	s.widget.deleteWidget(key)
 	s.updater.Update()
 	return s
 }
 
+// GetWidgetHistory returns the last recorded values of the widget by the given key, oldest first.
+func (s *Skeleton) GetWidgetHistory(key string) []WidgetHistoryEntry {
+	key = s.normalizeKey(key)
+	return s.widget.GetWidgetHistory(key)
+}
+
+// SetWidgetHistorySize sets the number of values kept per widget. Zero disables the history.
+func (s *Skeleton) SetWidgetHistorySize(size int) *Skeleton {
+	s.widget.SetHistorySize(size)
+	return s
+}
+
+// SetStatusBar sets the segment based status bar, it is rendered instead of the widgets.
+// Passing nil restores the widgets.
+func (s *Skeleton) SetStatusBar(bar *StatusBar) *Skeleton {
+	s.widget.SetStatusBar(bar)
+	s.updater.Update()
+	return s
+}
+
+// GetStatusBar returns the status bar, or nil if it is not set.
+func (s *Skeleton) GetStatusBar() *StatusBar {
+	return s.widget.statusBar
+}
+
 // DeleteAllWidgets deletes all the widgets.
 func (s *Skeleton) DeleteAllWidgets() *Skeleton {
 	s.widget.DeleteAllWidgets()
@@ -317,10 +702,10 @@ func (s *Skeleton) DeleteAllWidgets() *Skeleton {
 
 // SetActivePage sets the active page by the given key.
 func (s *Skeleton) SetActivePage(key string) *Skeleton {
+	key = s.normalizeKey(key)
 	for i, header := range s.header.headers {
 		if header.key == key {
-			s.currentTab = i
-			s.header.SetCurrentTab(i)
+			s.setCurrentTab(i)
 			s.updater.Update()
 			break
 		}
@@ -343,42 +728,102 @@ func (s *Skeleton) IAMActivePageCmd() tea.Cmd {
 	}
 }
 
-func (s *Skeleton) switchPage(cmds []tea.Cmd, position string) []tea.Cmd {
-	if s.IsTabsLocked() {
-		return cmds
+// setCurrentTab sets the current tab index and records the previous tab in the navigation history.
+func (s *Skeleton) setCurrentTab(tab int) {
+	if tab != s.currentTab && s.currentTab < len(s.header.headers) {
+		s.navigation.visit(s.header.headers[s.currentTab].key)
+	}
+	s.activateTab(tab)
+}
+
+// activateTab makes the tab at the given index the current one without recording the navigation history.
+// Its badge is cleared and the change is announced in screen-reader mode.
+func (s *Skeleton) activateTab(tab int) {
+	changed := tab != s.currentTab
+	var from string
+	if changed && s.currentTab < len(s.header.headers) {
+		from = s.header.headers[s.currentTab].key
 	}
+	s.currentTab = tab
+	s.header.SetCurrentTab(tab)
 
+	if tab < len(s.header.headers) {
+		// a tab of another workspace brings its workspace along
+		if !s.header.isVisible(tab) {
+			s.header.activeWorkspace = s.header.tabWorkspaces[s.header.headers[tab].key]
+		}
+		s.rememberWorkspaceTab(tab)
+		s.pageLimit.view(s.header.headers[tab].key)
+		delete(s.header.tabBadges, s.header.headers[tab].key)
+		s.header.calculateTitleLength()
+	}
+
+	if changed {
+		// the widgets of the previous page are hidden and the ones of the new page are shown
+		s.refreshKeyHints()
+		s.widget.calculateWidgetLength()
+	}
+	if changed && tab < len(s.header.headers) {
+		s.Announce(fmt.Sprintf("Tab %s", s.header.headers[tab].title))
+		s.recordInteraction(Interaction{Kind: InteractionTabSwitched, Page: s.header.headers[tab].key, From: from})
+	}
+}
+
+// JumpToTab activates the tab at the given index. It returns false if the index is out of range,
+// the tab is locked or disabled, or it is already active.
+func (s *Skeleton) JumpToTab(index int) bool {
+	if index < 0 || index >= len(s.pages) || index == s.currentTab {
+		return false
+	}
+	if !s.header.canActivate(s.header.headers[index].key) {
+		return false
+	}
+
+	s.setCurrentTab(index)
+	s.updater.Update()
+	return true
+}
+
+func (s *Skeleton) switchPage(cmds []tea.Cmd, position string) []tea.Cmd {
 	currentTab := s.currentTab
 	totalTabs := len(s.pages)
-	
+
+	// the reason of the first skipped tab is flashed, so the user knows why it is skipped
+	blocked := -1
+	defer func() { s.showBlockedReason(blocked) }()
+
 	switch position {
 	case "left":
-		// Start from current position and move left until we find an unlocked tab
+		// Start from current position and move left until we find a tab which can be activated
 		for i := 0; i < totalTabs; i++ {
 			nextTab := (currentTab - 1 - i + totalTabs) % totalTabs
-			if !s.IsTabLocked(s.header.headers[nextTab].key) {
-				s.currentTab = nextTab
-				s.header.SetCurrentTab(nextTab)
-				return append(cmds, s.IAMActivePageCmd())
-			}
 			// If wrapping is disabled and we've gone past the beginning, stop
 			if !s.properties.wrapTabs && nextTab > currentTab {
 				break
 			}
+			if s.header.canActivate(s.header.headers[nextTab].key) && s.header.isVisible(nextTab) {
+				s.setCurrentTab(nextTab)
+				return append(cmds, s.IAMActivePageCmd())
+			}
+			if blocked < 0 && nextTab != currentTab && s.header.isVisible(nextTab) {
+				blocked = nextTab
+			}
 		}
 	case "right":
-		// Start from current position and move right until we find an unlocked tab
+		// Start from current position and move right until we find a tab which can be activated
 		for i := 0; i < totalTabs; i++ {
 			nextTab := (currentTab + 1 + i) % totalTabs
-			if !s.IsTabLocked(s.header.headers[nextTab].key) {
-				s.currentTab = nextTab
-				s.header.SetCurrentTab(nextTab)
-				return append(cmds, s.IAMActivePageCmd())
-			}
 			// If wrapping is disabled and we've gone past the end, stop
 			if !s.properties.wrapTabs && nextTab < currentTab {
 				break
 			}
+			if s.header.canActivate(s.header.headers[nextTab].key) && s.header.isVisible(nextTab) {
+				s.setCurrentTab(nextTab)
+				return append(cmds, s.IAMActivePageCmd())
+			}
+			if blocked < 0 && nextTab != currentTab && s.header.isVisible(nextTab) {
+				blocked = nextTab
+			}
 		}
 	}
 
@@ -395,8 +840,8 @@ func (s *Skeleton) updateSkeleton(msg tea.Msg) []tea.Cmd {
 	s.widget, cmd = s.widget.Update(msg)
 	cmds = append(cmds, cmd)
 
-	s.pages[s.currentTab], cmd = s.pages[s.currentTab].Update(msg)
-	cmds = append(cmds, cmd)
+	cmds = append(cmds, s.updatePageAt(s.currentTab, msg))
+	s.refreshKeyHints()
 
 	return cmds
 }
@@ -406,19 +851,44 @@ func (s *Skeleton) Init() tea.Cmd {
 		panic("skeleton: no pages added, please add at least one page")
 	}
 
-	return tea.Batch(tea.EnterAltScreen, s.updater.Listen(), s.header.Init(), s.widget.Init())
+	s.applyWindowsCompatibility()
+
+	cmds := []tea.Cmd{s.screenMode(), s.updater.Listen(), s.header.Init(), s.widget.Init(), s.tickWidgets(), s.dynamicWidgetStartCmd()}
+	if s.properties.mouseEnabled {
+		cmds = append(cmds, s.mouseMode())
+	}
+
+	return tea.Batch(cmds...)
 }
 
 func (s *Skeleton) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	model, cmd := s.update(msg)
+	return model, tea.Batch(cmd, s.flashExpiryCmd(), s.tickWidgets(), s.dynamicWidgetStartCmd())
+}
+
+// update handles the message, Update schedules the ends of the widget highlights it started and starts
+// ticking the animated widgets and refreshing the dynamic widgets it added, so none of them depends on a
+// message which could be dropped.
+func (s *Skeleton) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	s.currentTab = s.header.GetCurrentTab()
+	s.messageLog.record(msg, s.clock.Now())
+
+	if !s.permitted(msg) {
+		return s, nil
+	}
 
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
+		// terminals may report zero or negative sizes during resize storms,
+		// the last known good size is kept until a valid one arrives
+		if msg.Width <= 0 || msg.Height <= 0 {
+			return s, nil
+		}
 		if !s.termReady {
-			if msg.Width > 0 && msg.Height > 0 {
-				s.termReady = true
-			}
+			s.termReady = true
 		}
+		s.terminalSize = Size{Width: msg.Width, Height: msg.Height}
+		msg = s.fitWindowSize(msg)
 		s.viewport.Width = msg.Width
 		s.viewport.Height = msg.Height
 
@@ -426,13 +896,97 @@ func (s *Skeleton) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case tea.KeyMsg:
 		var cmds []tea.Cmd
+		if s.IsModalOpen() {
+			if !s.quitKeyDisabled && key.Matches(msg, s.KeyMap.Quit) {
+				return s, tea.Quit
+			}
+			s.handleModalKey(msg)
+			return s, nil
+		}
+		if cmd, consumed := s.handleFocusKey(msg); consumed {
+			return s, cmd
+		}
+		s.recordCommand(msg)
+		hotkeyPage, isHotkey := s.pageHotkeys[msg.String()]
 		switch {
-		case key.Matches(msg, s.KeyMap.Quit):
-			return s, tea.Quit
+		case !s.quitKeyDisabled && key.Matches(msg, s.KeyMap.Quit):
+			return s, s.requestQuit()
+		case isHotkey && s.hasPage(hotkeyPage):
+			index := s.pageIndex(hotkeyPage)
+			if s.JumpToTab(index) {
+				cmds = append(cmds, s.IAMActivePageCmd())
+			} else {
+				s.showBlockedReason(index)
+			}
+		case key.Matches(msg, s.KeyMap.ClosePage):
+			s.closePage(s.GetActivePage())
+			return s, nil
 		case key.Matches(msg, s.KeyMap.SwitchTabLeft):
 			cmds = s.switchPage(cmds, "left")
 		case key.Matches(msg, s.KeyMap.SwitchTabRight):
 			cmds = s.switchPage(cmds, "right")
+		case key.Matches(msg, s.KeyMap.MovePageLeft) && !s.IsTabsLocked():
+			s.MovePageLeft()
+		case key.Matches(msg, s.KeyMap.MovePageRight) && !s.IsTabsLocked():
+			s.MovePageRight()
+		case key.Matches(msg, s.KeyMap.NextTabPage) && s.header.isPaginated():
+			if s.NextTabPage() {
+				cmds = append(cmds, s.IAMActivePageCmd())
+			}
+		case key.Matches(msg, s.KeyMap.PrevTabPage) && s.header.isPaginated():
+			if s.PrevTabPage() {
+				cmds = append(cmds, s.IAMActivePageCmd())
+			}
+		case key.Matches(msg, s.KeyMap.HistoryBack):
+			if s.NavigateBack() {
+				cmds = append(cmds, s.IAMActivePageCmd())
+			}
+		case key.Matches(msg, s.KeyMap.HistoryForward):
+			if s.NavigateForward() {
+				cmds = append(cmds, s.IAMActivePageCmd())
+			}
+		case key.Matches(msg, s.KeyMap.JumpToTab...):
+			for i, binding := range s.KeyMap.JumpToTab {
+				if key.Matches(msg, binding) {
+					// the number counts the tabs shown in the active workspace
+					index := s.header.visibleIndex(i)
+					if s.JumpToTab(index) {
+						cmds = append(cmds, s.IAMActivePageCmd())
+					} else {
+						s.showBlockedReason(index)
+					}
+					break
+				}
+			}
+		case key.Matches(msg, s.KeyMap.NewTab) && s.newTabHandler != nil:
+			return s, s.NewTab()
+		case key.Matches(msg, s.KeyMap.Help):
+			s.helpVisible = !s.helpVisible
+			return s, nil
+		case key.Matches(msg, s.KeyMap.CycleTheme):
+			s.NextTheme()
+		case key.Matches(msg, s.KeyMap.ToggleHeader):
+			s.ToggleHeader()
+		case key.Matches(msg, s.KeyMap.ToggleWidgets):
+			s.ToggleWidgets()
+		case key.Matches(msg, s.KeyMap.CloseOtherPages):
+			s.CloseOtherPages(s.GetActivePage())
+		case key.Matches(msg, s.KeyMap.ClosePagesToTheRight):
+			s.ClosePagesToTheRight(s.GetActivePage())
+		case key.Matches(msg, s.KeyMap.CycleWorkspace) && len(s.GetWorkspaces()) > 0:
+			previous := s.currentTab
+			s.NextWorkspace()
+			if s.currentTab != previous {
+				cmds = append(cmds, s.IAMActivePageCmd())
+			}
+		case key.Matches(msg, s.KeyMap.ReopenPage):
+			if s.ReopenClosedPage() {
+				cmds = append(cmds, s.IAMActivePageCmd())
+			}
+		}
+		if s.permissions.ReadOnly {
+			// the navigation keys are handled, the pages do not get the input
+			return s, tea.Batch(cmds...)
 		}
 		cmds = append(cmds, s.updateSkeleton(msg)...)
 		return s, tea.Batch(cmds...)
@@ -447,6 +1001,75 @@ func (s *Skeleton) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, s.updater.Listen())
 		return s, tea.Batch(cmds...)
 
+	case broadcastMsg:
+		cmds := s.updateAllPages(msg.msg)
+		cmds = append(cmds, s.updater.Listen())
+		return s, tea.Batch(cmds...)
+
+	case quitMsg:
+		return s, tea.Quit
+
+	case toastMsg:
+		return s, tea.Batch(s.addToast(msg), s.updater.Listen())
+
+	case toastExpiredMsg:
+		s.removeToast(msg.id)
+		return s, nil
+
+	case statusMessageMsg:
+		return s, tea.Batch(s.showStatusMessage(msg), s.updater.Listen())
+
+	case statusMessageExpiredMsg:
+		s.expireStatusMessage(msg.id)
+		return s, nil
+
+	case statusRowPushMsg:
+		return s, tea.Batch(s.pushStatusRow(msg), s.updater.Listen())
+
+	case statusRowExpiredMsg:
+		s.expireStatusRow(msg.id)
+		return s, nil
+
+	case widgetTickMsg:
+		return s, s.advanceWidgets()
+
+	case widgetFlashExpiredMsg:
+		s.widget.endFlash(msg)
+		return s, nil
+
+	case runMsg:
+		msg.fn()
+		return s, s.updater.Listen()
+
+	case dynamicWidgetMsg:
+		return s, s.updateDynamicWidget(msg)
+
+	case resizeMsg:
+		size := msg.size
+		return s, tea.Batch(func() tea.Msg { return size }, s.updater.Listen())
+
+	case screenModeMsg:
+		return s, tea.Batch(s.screenMode(), s.resizeCmd(), s.updater.Listen())
+
+	case mouseModeMsg:
+		if msg.enabled {
+			return s, tea.Batch(s.mouseMode(), s.updater.Listen())
+		}
+		return s, tea.Batch(tea.DisableMouse, s.updater.Listen())
+
+	case tea.MouseMsg:
+		focusCmd := s.focusFromMouse(msg)
+		cmd, consumed := s.handleMouse(msg)
+		cmds := []tea.Cmd{focusCmd, cmd}
+		if !consumed {
+			cmds = append(cmds, s.updateSkeleton(s.localMouseMsg(msg))...)
+		}
+		cmds = append(cmds, s.updater.Listen())
+		return s, tea.Batch(cmds...)
+
+	case pageMsg:
+		return s, tea.Batch(s.updatePage(msg.key, msg.msg), s.updater.Listen())
+
 	case HeaderSizeMsg:
 		s.termSizeNotEnoughToHandleHeaders = msg.NotEnoughToHandleHeaders
 		return s, nil
@@ -456,6 +1079,11 @@ func (s *Skeleton) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return s, nil
 
 	case DeletePageMsg:
+		msg.Key = s.normalizeKey(msg.Key)
+		if s.IsPageDirty(msg.Key) {
+			s.requestClose(msg.Key)
+			return s, s.updater.Listen()
+		}
 		s.deleteMsg(msg.Key)
 		cmds := s.updateSkeleton(msg)
 		cmds = append(cmds, s.IAMActivePageCmd())
@@ -480,44 +1108,131 @@ func (s *Skeleton) View() string {
 		return "terminal size is not enough to show widgets"
 	}
 
+	s.widget.description = s.header.tabDescriptions[s.GetActivePage()]
+	if reason := s.header.blockedReason(s.header.hoveredTab); reason != "" {
+		s.widget.description = reason
+	}
+
+	start := time.Now()
+	headerView := s.placeRegion(s.header.View(), s.header.renderer != nil)
+	headerDone := time.Now()
+	footerView := s.placeRegion(s.widget.View(), s.widget.renderer != nil)
+	topBarView := s.placeRegion(s.widget.topView(), false)
+	footerDone := time.Now()
+	subtitleView := s.subtitleView()
+
 	// Calculate available height for body
-	headerHeight := lipgloss.Height(s.header.View())
-	footerHeight := lipgloss.Height(s.widget.View())
+	headerHeight := lipgloss.Height(headerView)
+	footerHeight := lipgloss.Height(footerView)
+	topBarHeight := 0
+	if topBarView != "" {
+		topBarHeight = lipgloss.Height(topBarView)
+	}
+	subtitleHeight := 0
+	if subtitleView != "" {
+		subtitleHeight = 1
+	}
+	statusRowView := s.widget.statusRowView()
+	statusRowHeight := 0
+	if statusRowView != "" {
+		statusRowHeight = 1
+	}
 
-	bodyHeight := s.viewport.Height - headerHeight - footerHeight
+	bodyHeight := max(s.viewport.Height-headerHeight-footerHeight-topBarHeight-subtitleHeight-statusRowHeight, 0)
 
 	// Style for the body content
 	base := lipgloss.NewStyle().
 		BorderForeground(lipgloss.Color(s.properties.borderColor)).
-		Align(s.properties.pagePosition).
-		Border(lipgloss.RoundedBorder()).
+		Align(s.activePageAlignment()).
+		Border(s.properties.glyphs.Frame).
 		BorderTop(false).BorderBottom(false).
-		Width(s.viewport.Width - 2).
+		Width(max(s.viewport.Width-2, 0)).
 		MaxHeight(bodyHeight)
 
-	// Get body content
-	body := s.pages[s.currentTab].View()
+	// Get body content with the view processors applied, padded or clipped to the available height
+	body := s.processView(s.GetActivePage(), s.viewPageAt(s.currentTab))
+	body = fitHeight(body, bodyHeight)
+
+	if s.header.isSidebar() {
+		body = s.withSidebar(body, bodyHeight)
+	}
 
-	// Add padding if content is shorter than available height
-	if lipgloss.Height(body) < bodyHeight {
-		body += strings.Repeat("\n", bodyHeight-lipgloss.Height(body))
+	renderedBody := base.Render(body)
+	if len(s.toasts) > 0 {
+		toasts := s.toastsView((s.viewport.Width - 2) / 2)
+		renderedBody = placeOverlayAt(s.viewport.Width-1-lipgloss.Width(toasts), 0, toasts, renderedBody)
+	}
+	if s.helpVisible {
+		renderedBody = placeOverlay(s.helpView(s.viewport.Width-2), renderedBody)
+	}
+	if s.IsModalOpen() {
+		renderedBody = placeOverlay(s.modalView(s.viewport.Width-2), renderedBody)
 	}
 
-	return lipgloss.JoinVertical(lipgloss.Top,
-		s.header.View(),
-		base.Render(body),
-		s.widget.View())
+	regions := []string{headerView, renderedBody, footerView}
+	if bodyHeight == 0 {
+		// the border style renders a line even for an empty body
+		regions = []string{headerView, footerView}
+	}
+	if topBarView != "" {
+		regions = slices.Insert(regions, 1, topBarView)
+	}
+	if subtitleView != "" {
+		regions = slices.Insert(regions, 1, subtitleView)
+	}
+	if statusRowView != "" {
+		regions = slices.Insert(regions, len(regions)-1, statusRowView)
+	}
+	if s.isHeaderAtBottom() {
+		slices.Reverse(regions)
+	}
+	frame := lipgloss.JoinVertical(lipgloss.Top, regions...)
+	if s.viewport.Height > 0 {
+		// the header and the widgets do not shrink, on a very low terminal they are clipped
+		frame = fitHeight(frame, s.viewport.Height)
+	}
+
+	end := time.Now()
+	s.recordFrame(FrameTiming{
+		Total:   end.Sub(start),
+		Header:  headerDone.Sub(start),
+		Widgets: footerDone.Sub(headerDone),
+		Body:    end.Sub(footerDone),
+	})
+	s.publishFrame(frame)
+
+	return frame
 }
 
 // LockTab locks a specific tab by its key
 func (s *Skeleton) LockTab(key string) *Skeleton {
+	key = s.normalizeKey(key)
+	s.header.LockTab(key)
+	s.updater.Update()
+	return s
+}
+
+// LockTabWithReason locks a specific tab by its key, the reason is flashed on the footer line
+// when the user tries to switch to the tab.
+func (s *Skeleton) LockTabWithReason(key string, reason string) *Skeleton {
+	key = s.normalizeKey(key)
 	s.header.LockTab(key)
+	if reason != "" {
+		s.header.lockReasons[key] = reason
+	}
 	s.updater.Update()
 	return s
 }
 
+// GetTabLockReason returns the reason the tab by the given key is locked, it is empty if not set.
+func (s *Skeleton) GetTabLockReason(key string) string {
+	key = s.normalizeKey(key)
+	return s.header.lockReasons[key]
+}
+
 // UnlockTab unlocks a specific tab by its key
 func (s *Skeleton) UnlockTab(key string) *Skeleton {
+	key = s.normalizeKey(key)
 	s.header.UnlockTab(key)
 	s.updater.Update()
 	return s
@@ -525,6 +1240,7 @@ func (s *Skeleton) UnlockTab(key string) *Skeleton {
 
 // IsTabLocked checks if a specific tab is locked
 func (s *Skeleton) IsTabLocked(key string) bool {
+	key = s.normalizeKey(key)
 	return s.header.IsTabLocked(key)
 }
 
@@ -555,3 +1271,53 @@ func (s *Skeleton) LockTabsToTheLeft() *Skeleton {
 	s.updater.Update()
 	return s
 }
+
+// CloseOtherPages closes all pages except the one by the given key, which becomes the active page.
+// The pages are closed like by their close glyph: locked and pinned tabs are kept, the close handler
+// can veto closing and dirty pages are confirmed first.
+func (s *Skeleton) CloseOtherPages(key string) *Skeleton {
+	key = s.normalizeKey(key)
+	index := s.pageIndex(key)
+	if index < 0 {
+		return s
+	}
+	s.JumpToTab(index)
+
+	for _, hdr := range s.header.headers {
+		if hdr.key != key {
+			s.closePage(hdr.key)
+		}
+	}
+	return s
+}
+
+// ClosePagesToTheRight closes all pages to the right of the page by the given key, like CloseOtherPages.
+func (s *Skeleton) ClosePagesToTheRight(key string) *Skeleton {
+	key = s.normalizeKey(key)
+	index := s.pageIndex(key)
+	if index < 0 {
+		return s
+	}
+	if s.currentTab > index {
+		s.JumpToTab(index)
+	}
+
+	for _, hdr := range s.header.headers[index+1:] {
+		s.closePage(hdr.key)
+	}
+	return s
+}
+
+// fitHeight pads the given content with empty lines or clips it to the given height.
+// Pages taller than the body are clipped from the bottom, so their top stays visible.
+func fitHeight(content string, height int) string {
+	if height <= 0 {
+		return ""
+	}
+
+	lines := strings.Split(content, "\n")
+	if len(lines) > height {
+		return strings.Join(lines[:height], "\n")
+	}
+	return content + strings.Repeat("\n", height-len(lines))
+}
@@ -0,0 +1,91 @@
+package skeleton
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// tallPage renders more lines than the body of the Skeleton has.
+type tallPage struct {
+	lines int
+}
+
+func (p tallPage) Init() tea.Cmd                       { return nil }
+func (p tallPage) Update(tea.Msg) (tea.Model, tea.Cmd) { return p, nil }
+
+func (p tallPage) View() string {
+	lines := make([]string, p.lines)
+	for i := range lines {
+		lines[i] = fmt.Sprintf("line %d", i+1)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestRenderPageTallerThanBody(t *testing.T) {
+	for _, height := range []int{1, 3, 5, 8, 24} {
+		t.Run(fmt.Sprint(height), func(t *testing.T) {
+			s := NewSkeleton()
+			s.AddPage("tall", "Tall", tallPage{lines: 100})
+			s.AddWidget("status", "ready")
+
+			view := s.RenderSnapshot(80, height)
+			if got := lipgloss.Height(view); got != height {
+				t.Errorf("frame is %d lines high, want %d:\n%s", got, height, view)
+			}
+		})
+	}
+}
+
+// updateSync handles the message and then the messages it sent through the updater, the commands are not run.
+func updateSync(s *Skeleton, msg tea.Msg) {
+	s.Update(msg)
+	for {
+		select {
+		case msg := <-s.updater.rcv:
+			s.Update(msg)
+		default:
+			return
+		}
+	}
+}
+
+func TestClosePageKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		dirty     bool
+		locked    bool
+		wantPages int
+		wantModal bool
+	}{
+		{name: "clean", wantPages: 1},
+		{name: "dirty", dirty: true, wantPages: 2, wantModal: true},
+		{name: "locked", locked: true, wantPages: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSkeleton()
+			s.AddPage("first", "First", tallPage{lines: 1})
+			s.AddPage("second", "Second", tallPage{lines: 1})
+			updateSync(s, tea.WindowSizeMsg{Width: 80, Height: 24})
+			if tt.dirty {
+				s.MarkPageDirty("first")
+			}
+			if tt.locked {
+				s.LockTab("first")
+			}
+
+			updateSync(s, tea.KeyMsg{Type: tea.KeyCtrlW})
+			if got := len(s.pages); got != tt.wantPages {
+				t.Errorf("%d pages left, want %d", got, tt.wantPages)
+			}
+			if got := s.IsModalOpen(); got != tt.wantModal {
+				t.Errorf("confirmation open is %v, want %v", got, tt.wantModal)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package skeleton
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// DefaultSnapshotSizes are the sizes used by SnapshotThemes when no sizes are given.
+var DefaultSnapshotSizes = []Size{
+	{Width: 60, Height: 16},
+	{Width: 80, Height: 24},
+	{Width: 120, Height: 30},
+}
+
+// SnapshotDiff is a snapshot which does not match its golden file.
+type SnapshotDiff struct {
+	// Theme is the theme name the snapshot is rendered with
+	Theme string
+
+	// Size is the terminal size the snapshot is rendered at
+	Size Size
+
+	// Golden is the path of the golden file
+	Golden string
+
+	// Missing reports there is no golden file yet
+	Missing bool
+
+	// Line is the first different line (1-based), Want and Got are its contents
+	Line int
+	Want string
+	Got  string
+}
+
+// String returns a human readable description of the diff.
+func (d SnapshotDiff) String() string {
+	if d.Missing {
+		return fmt.Sprintf("%s@%s: golden file %s is missing", d.Theme, d.Size, d.Golden)
+	}
+	return fmt.Sprintf("%s@%s: line %d differs from %s\n  want: %q\n  got:  %q", d.Theme, d.Size, d.Line, d.Golden, d.Want, d.Got)
+}
+
+// snapshotCurrentTheme is the theme name of the snapshots if no theme is registered.
+const snapshotCurrentTheme = "current"
+
+// RenderSnapshot renders the Skeleton at the given size synchronously, with true colors so the styling
+// is part of the output. It is meant for developer tooling, not for a running program.
+func (s *Skeleton) RenderSnapshot(width int, height int) string {
+	profile := lipgloss.ColorProfile()
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(profile)
+
+	previous := Size{Width: s.viewport.Width, Height: s.viewport.Height}
+	s.resizeSync(Size{Width: width, Height: height})
+	view := s.View()
+	if previous.Width > 0 && previous.Height > 0 {
+		s.resizeSync(previous)
+	}
+	return view
+}
+
+// SnapshotThemes renders the Skeleton under every registered theme at the given sizes and compares the
+// renders with the golden files in dir. With update the golden files are (re)written instead. The applied
+// theme is restored afterwards.
+func (s *Skeleton) SnapshotThemes(dir string, sizes []Size, update bool) ([]SnapshotDiff, error) {
+	if len(sizes) == 0 {
+		sizes = DefaultSnapshotSizes
+	}
+	if update {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+
+	themes := s.GetThemes()
+	if len(themes) == 0 {
+		themes = []string{snapshotCurrentTheme}
+	}
+
+	current := s.currentTheme
+	defer func() {
+		if current >= 0 {
+			s.applyTheme(current)
+		}
+	}()
+
+	var diffs []SnapshotDiff
+	for _, theme := range themes {
+		if theme != snapshotCurrentTheme || len(s.themes) > 0 {
+			s.SetTheme(theme)
+		}
+		for _, size := range sizes {
+			golden := filepath.Join(dir, snapshotFileName(theme, size))
+			got := s.RenderSnapshot(size.Width, size.Height)
+
+			if update {
+				if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+					return diffs, err
+				}
+				continue
+			}
+
+			want, err := os.ReadFile(golden)
+			if errors.Is(err, os.ErrNotExist) {
+				diffs = append(diffs, SnapshotDiff{Theme: theme, Size: size, Golden: golden, Missing: true})
+				continue
+			}
+			if err != nil {
+				return diffs, err
+			}
+			if diff, ok := diffSnapshot(string(want), got); !ok {
+				diff.Theme, diff.Size, diff.Golden = theme, size, golden
+				diffs = append(diffs, diff)
+			}
+		}
+	}
+
+	return diffs, nil
+}
+
+// resizeSync applies the given terminal size without going through the program loop.
+func (s *Skeleton) resizeSync(size Size) {
+	msg := tea.WindowSizeMsg{Width: size.Width, Height: size.Height}
+	s.termReady = size.Width > 0 && size.Height > 0
+	s.viewport.Width = size.Width
+	s.viewport.Height = size.Height
+
+	s.header, _ = s.header.Update(msg)
+	s.widget, _ = s.widget.Update(msg)
+	if len(s.pages) > 0 {
+		s.updatePageAt(s.currentTab, msg)
+	}
+
+	if msg, ok := s.header.calculateTitleLength()().(HeaderSizeMsg); ok {
+		s.termSizeNotEnoughToHandleHeaders = msg.NotEnoughToHandleHeaders
+	}
+	if msg, ok := s.widget.calculateWidgetLength()().(WidgetSizeMsg); ok {
+		s.termSizeNotEnoughToHandleWidgets = msg.NotEnoughToHandleWidgets
+	}
+}
+
+// snapshotFileName returns the golden file name of the given theme and size.
+func snapshotFileName(theme string, size Size) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, theme)
+	return fmt.Sprintf("%s_%s.golden", name, size)
+}
+
+// diffSnapshot compares the snapshots line by line, it returns false with the first different line.
+func diffSnapshot(want string, got string) (SnapshotDiff, bool) {
+	if want == got {
+		return SnapshotDiff{}, true
+	}
+
+	wantLines := strings.Split(want, "\n")
+	gotLines := strings.Split(got, "\n")
+	for i := 0; i < max(len(wantLines), len(gotLines)); i++ {
+		var w, g string
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+		if w != g || i >= len(wantLines) || i >= len(gotLines) {
+			return SnapshotDiff{Line: i + 1, Want: w, Got: g}, false
+		}
+	}
+	return SnapshotDiff{}, false
+}
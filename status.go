@@ -0,0 +1,71 @@
+package skeleton
+
+import (
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// statusMessageMsg shows the status message, it is sent through the updater to keep
+// SetStatusMessage safe for goroutines.
+type statusMessageMsg struct {
+	id   int
+	text string
+	ttl  time.Duration
+}
+
+// statusMessageExpiredMsg clears the status message if it is still the one by the given id.
+type statusMessageExpiredMsg struct {
+	id int
+}
+
+// statusMessageState is hold the id of the latest status message.
+type statusMessageState struct {
+	mu     sync.Mutex
+	lastID int
+}
+
+// next returns the id of a new status message.
+func (st *statusMessageState) next() int {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.lastID++
+	return st.lastID
+}
+
+// SetStatusMessage shows the given message on the footer line next to the widgets, or on the status row
+// if it is reserved, see SetStatusRow. It is cleared automatically after ttl, a zero ttl keeps it until ClearStatusMessage is called.
+func (s *Skeleton) SetStatusMessage(text string, ttl time.Duration) *Skeleton {
+	s.updater.UpdateWithMsg(statusMessageMsg{
+		id:   s.statusMessage.next(),
+		text: text,
+		ttl:  ttl,
+	})
+	return s
+}
+
+// ClearStatusMessage clears the status message.
+func (s *Skeleton) ClearStatusMessage() *Skeleton {
+	return s.SetStatusMessage("", 0)
+}
+
+// showStatusMessage shows the given status message and returns the command which expires it, nil if it
+// does not expire. The expiry is a command rather than an update, so it can not be dropped.
+func (s *Skeleton) showStatusMessage(msg statusMessageMsg) tea.Cmd {
+	s.statusMessageID = msg.id
+	s.widget.statusMessage = msg.text
+
+	if msg.ttl <= 0 {
+		return nil
+	}
+	return s.clockTick(msg.ttl, statusMessageExpiredMsg{id: msg.id})
+}
+
+// expireStatusMessage clears the status message unless a newer one replaced it.
+func (s *Skeleton) expireStatusMessage(id int) {
+	if s.statusMessageID != id {
+		return
+	}
+	s.widget.statusMessage = ""
+}
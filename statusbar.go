@@ -0,0 +1,246 @@
+package skeleton
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// StatusSegment is a single part of the StatusBar.
+type StatusSegment struct {
+	key        string
+	text       string
+	provider   func() string
+	foreground string
+	background string
+	priority   int
+	maxWidth   int
+}
+
+// NewSegment returns a new StatusSegment with the given key.
+func NewSegment(key string) *StatusSegment {
+	return &StatusSegment{key: key}
+}
+
+// Text sets the static text of the segment.
+func (seg *StatusSegment) Text(text string) *StatusSegment {
+	seg.text = text
+	return seg
+}
+
+// Provider sets a function which returns the text of the segment on every render.
+// It takes precedence over the static text.
+func (seg *StatusSegment) Provider(provider func() string) *StatusSegment {
+	seg.provider = provider
+	return seg
+}
+
+// Foreground sets the text color of the segment.
+func (seg *StatusSegment) Foreground(color string) *StatusSegment {
+	seg.foreground = color
+	return seg
+}
+
+// Background sets the background color of the segment.
+func (seg *StatusSegment) Background(color string) *StatusSegment {
+	seg.background = color
+	return seg
+}
+
+// Priority sets the priority of the segment. When there is not enough space,
+// segments with lower priority are dropped first.
+func (seg *StatusSegment) Priority(priority int) *StatusSegment {
+	seg.priority = priority
+	return seg
+}
+
+// MaxWidth sets the maximum width of the segment text, longer texts are truncated. Zero means unlimited.
+func (seg *StatusSegment) MaxWidth(width int) *StatusSegment {
+	seg.maxWidth = width
+	return seg
+}
+
+// GetKey returns the key of the segment.
+func (seg *StatusSegment) GetKey() string {
+	return seg.key
+}
+
+// value returns the current text of the segment.
+func (seg *StatusSegment) value() string {
+	text := seg.text
+	if seg.provider != nil {
+		text = seg.provider()
+	}
+	if seg.maxWidth > 0 {
+		text = truncateText(text, seg.maxWidth)
+	}
+	return text
+}
+
+// render renders the segment with its colors.
+func (seg *StatusSegment) render(text string) string {
+	style := lipgloss.NewStyle().Padding(0, 1)
+	if seg.foreground != "" {
+		style = style.Foreground(lipgloss.Color(seg.foreground))
+	}
+	if seg.background != "" {
+		style = style.Background(lipgloss.Color(seg.background))
+	}
+	return style.Render(text)
+}
+
+// StatusBar is a segment based alternative to the key/value widgets.
+// Segments are rendered from left to right at the bottom line of the Skeleton.
+type StatusBar struct {
+	// segments are hold the segments of the status bar
+	segments []*StatusSegment
+
+	// separator is rendered between the segments, it overrides the separator of the glyph set
+	separator string
+
+	// glyphSeparator is the separator of the glyph set in use
+	glyphSeparator string
+
+	// borderColor is used for the separators of the segments without background
+	borderColor string
+
+	updater *Updater
+}
+
+// NewStatusBar returns a new StatusBar.
+func NewStatusBar() *StatusBar {
+	return &StatusBar{
+		glyphSeparator: UnicodeGlyphs().Separator,
+		borderColor:    "39",
+		updater:        NewUpdater(),
+	}
+}
+
+// Add adds the given segments to the end of the status bar.
+func (b *StatusBar) Add(segments ...*StatusSegment) *StatusBar {
+	b.segments = append(b.segments, segments...)
+	b.updater.Update()
+	return b
+}
+
+// Remove removes the segment by the given key.
+func (b *StatusBar) Remove(key string) *StatusBar {
+	for i, seg := range b.segments {
+		if seg.key == key {
+			b.segments = append(b.segments[:i], b.segments[i+1:]...)
+			break
+		}
+	}
+	b.updater.Update()
+	return b
+}
+
+// Segment returns the segment by the given key, or nil if it does not exist.
+func (b *StatusBar) Segment(key string) *StatusSegment {
+	for _, seg := range b.segments {
+		if seg.key == key {
+			return seg
+		}
+	}
+	return nil
+}
+
+// SetSegmentText sets the static text of the segment by the given key.
+func (b *StatusBar) SetSegmentText(key string, text string) *StatusBar {
+	if seg := b.Segment(key); seg != nil {
+		seg.text = text
+	}
+	b.updater.Update()
+	return b
+}
+
+// SetSeparator sets the separator rendered between the segments. Empty string restores the separator of the glyph set.
+func (b *StatusBar) SetSeparator(separator string) *StatusBar {
+	b.separator = separator
+	b.updater.Update()
+	return b
+}
+
+// Render renders the status bar within the given width. Segments with the lowest
+// priority are dropped until the bar fits, then the remaining text is truncated.
+func (b *StatusBar) Render(width int) string {
+	if width <= 0 || len(b.segments) == 0 {
+		return ""
+	}
+
+	texts := make(map[*StatusSegment]string, len(b.segments))
+	visible := make([]*StatusSegment, 0, len(b.segments))
+	for _, seg := range b.segments {
+		texts[seg] = seg.value()
+		visible = append(visible, seg)
+	}
+
+	// drop the lowest priority segments until the bar fits
+	for len(visible) > 1 && b.width(visible, texts) > width {
+		lowest := 0
+		for i, seg := range visible {
+			if seg.priority < visible[lowest].priority {
+				lowest = i
+			}
+		}
+		visible = append(visible[:lowest], visible[lowest+1:]...)
+	}
+
+	if overflow := b.width(visible, texts) - width; overflow > 0 {
+		last := visible[len(visible)-1]
+		texts[last] = truncateText(texts[last], lipgloss.Width(texts[last])-overflow)
+	}
+
+	var rendered strings.Builder
+	for i, seg := range visible {
+		if i > 0 {
+			rendered.WriteString(b.renderSeparator(visible[i-1], seg))
+		}
+		rendered.WriteString(seg.render(texts[seg]))
+	}
+
+	return rendered.String()
+}
+
+// width returns the rendered width of the given segments.
+func (b *StatusBar) width(segments []*StatusSegment, texts map[*StatusSegment]string) int {
+	var total int
+	for i, seg := range segments {
+		if i > 0 {
+			total += lipgloss.Width(b.getSeparator())
+		}
+		total += lipgloss.Width(texts[seg]) + 2 // for the padding of the segment
+	}
+	return total
+}
+
+// renderSeparator renders the separator between the given segments.
+// When the segments have backgrounds, the separator blends them like powerline.
+func (b *StatusBar) renderSeparator(prev, next *StatusSegment) string {
+	style := lipgloss.NewStyle().Foreground(lipgloss.Color(b.borderColor))
+	if next.background != "" {
+		style = style.Foreground(lipgloss.Color(next.background))
+	}
+	if prev.background != "" {
+		style = style.Background(lipgloss.Color(prev.background))
+	}
+	return style.Render(b.getSeparator())
+}
+
+// getSeparator returns the separator in use.
+func (b *StatusBar) getSeparator() string {
+	if b.separator != "" {
+		return b.separator
+	}
+	return b.glyphSeparator
+}
+
+// truncateText truncates the given text to the given width, adding an ellipsis when truncated.
+func truncateText(text string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	// ansi.Truncate keeps grapheme clusters (emoji, combining characters) intact and counts wide characters
+	return ansi.Truncate(text, width, "…")
+}
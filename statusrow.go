@@ -0,0 +1,104 @@
+package skeleton
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// defaultStatusRowTTL is how long a pushed status message is shown when no ttl is given.
+const defaultStatusRowTTL = 5 * time.Second
+
+// statusRowEntry is hold a message pushed to the status row.
+type statusRowEntry struct {
+	id   int
+	text string
+}
+
+// statusRowPushMsg pushes the message to the status row, it is sent through the updater to keep
+// PushStatus safe for goroutines.
+type statusRowPushMsg struct {
+	entry statusRowEntry
+	ttl   time.Duration
+}
+
+// statusRowExpiredMsg removes the pushed message by the given id from the status row.
+type statusRowExpiredMsg struct {
+	id int
+}
+
+// SetStatusRow reserves a line above the widget bar for the status messages, so they do not displace the
+// widgets on the footer line. The status message set by SetStatusMessage is shown there too.
+func (s *Skeleton) SetStatusRow(enabled bool) *Skeleton {
+	s.widget.statusRow = enabled
+	s.updater.Update()
+	return s
+}
+
+// IsStatusRowEnabled returns the status row is reserved or not.
+func (s *Skeleton) IsStatusRowEnabled() bool {
+	return s.widget.statusRow
+}
+
+// PushStatus shows the given message on the status row until ttl elapses, zero ttl is 5 seconds. The newest
+// message is shown, the older ones are shown again when the newer ones expire. It is safe for goroutines.
+func (s *Skeleton) PushStatus(text string, ttl time.Duration) *Skeleton {
+	if ttl <= 0 {
+		ttl = defaultStatusRowTTL
+	}
+	// the message waits for room in the updater instead of being dropped
+	s.updater.queueWithMsg(s.ctx, statusRowPushMsg{
+		entry: statusRowEntry{id: s.statusMessage.next(), text: strings.ReplaceAll(text, "\n", " ")},
+		ttl:   ttl,
+	})
+	return s
+}
+
+// pushStatusRow adds the message to the status row and returns the command which expires it.
+// The expiry is a command rather than an update, so it can not be dropped.
+func (s *Skeleton) pushStatusRow(msg statusRowPushMsg) tea.Cmd {
+	s.widget.statusRowEntries = append(s.widget.statusRowEntries, msg.entry)
+	return s.clockTick(msg.ttl, statusRowExpiredMsg{id: msg.entry.id})
+}
+
+// expireStatusRow removes the pushed message by the given id from the status row.
+func (s *Skeleton) expireStatusRow(id int) {
+	for i, entry := range s.widget.statusRowEntries {
+		if entry.id == id {
+			s.widget.statusRowEntries = append(s.widget.statusRowEntries[:i], s.widget.statusRowEntries[i+1:]...)
+			return
+		}
+	}
+}
+
+// statusRowView renders the status row between the side borders, it is empty if the row is not reserved.
+// The newest pushed message is shown, the status message when there is none.
+func (w *widget) statusRowView() string {
+	if !w.statusRow || !w.termReady {
+		return ""
+	}
+
+	text := w.statusMessage
+	if len(w.statusRowEntries) > 0 {
+		text = w.statusRowEntries[len(w.statusRowEntries)-1].text
+	}
+
+	borderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(w.properties.borderColor))
+	frame := w.properties.glyphs.Frame
+
+	// the text is kept apart from the borders by a space on both sides
+	width := max(w.viewport.Width-2, 0)
+	line := " " + truncateText(text, width-2)
+	line += strings.Repeat(" ", max(width-lipgloss.Width(line), 0))
+	return borderStyle.Render(frame.Left) + line + borderStyle.Render(frame.Right)
+}
+
+// statusRowHeight returns the rendered height of the status row.
+func (w *widget) statusRowHeight() int {
+	if w.statusRowView() == "" {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,18 @@
+package skeleton
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPushStatusDoesNotDropMessage(t *testing.T) {
+	s, _ := newFullSkeleton(t)
+	s.SetStatusRow(true)
+	s.PushStatus("building", time.Minute)
+	p := runTestProgram(t, s)
+
+	eventually(t, p, func() bool {
+		entries := s.widget.statusRowEntries
+		return len(entries) == 1 && entries[0].text == "building"
+	})
+}
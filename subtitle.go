@@ -0,0 +1,56 @@
+package skeleton
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SetPageSubtitle sets the subtitle of the page by the given key. The subtitle of the active page is shown
+// on a line under the tab bar, e.g. for the current path, the filter or the connection target.
+// An empty subtitle removes the line.
+func (s *Skeleton) SetPageSubtitle(key string, text string) *Skeleton {
+	key = s.normalizeKey(key)
+	if text == "" {
+		delete(s.header.tabSubtitles, key)
+	} else {
+		s.header.tabSubtitles[key] = strings.ReplaceAll(text, "\n", " ")
+	}
+	s.updater.Update()
+	return s
+}
+
+// GetPageSubtitle returns the subtitle of the page by the given key.
+func (s *Skeleton) GetPageSubtitle(key string) string {
+	key = s.normalizeKey(key)
+	return s.header.tabSubtitles[key]
+}
+
+// subtitleView renders the subtitle line of the active page between the side borders, it is empty if the
+// page has no subtitle.
+func (s *Skeleton) subtitleView() string {
+	if s.currentTab < 0 || s.currentTab >= len(s.header.headers) {
+		return ""
+	}
+	text := s.header.tabSubtitles[s.header.headers[s.currentTab].key]
+	if text == "" {
+		return ""
+	}
+
+	borderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(s.properties.borderColor))
+	frame := s.properties.glyphs.Frame
+
+	// the text is kept apart from the borders by a space on both sides
+	width := max(s.viewport.Width-2, 0)
+	line := lipgloss.NewStyle().Faint(true).Render(truncateText(text, width-2))
+	line = " " + line + strings.Repeat(" ", max(width-1-lipgloss.Width(line), 0))
+	return borderStyle.Render(frame.Left) + line + borderStyle.Render(frame.Right)
+}
+
+// subtitleHeight returns the rendered height of the subtitle line.
+func (s *Skeleton) subtitleHeight() int {
+	if s.subtitleView() == "" {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,208 @@
+package skeleton
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// StateDump is a snapshot of the state of the Skeleton.
+type StateDump struct {
+	ActivePage   string         `json:"activePage"`
+	Pages        []PageState    `json:"pages"`
+	Widgets      []WidgetLayout `json:"widgets"`
+	ClosedPages  []string       `json:"closedPages,omitempty"`
+	ModalOpen    bool           `json:"modalOpen"`
+	HelpVisible  bool           `json:"helpVisible"`
+	TabsLocked   bool           `json:"tabsLocked"`
+	Theme        string         `json:"theme,omitempty"`
+	Workspace    string         `json:"workspace,omitempty"`
+	Themes       []string       `json:"themes,omitempty"`
+	MouseEnabled bool           `json:"mouseEnabled"`
+	ScreenReader bool           `json:"screenReader"`
+	FrameTiming  FrameTiming    `json:"frameTiming"`
+	FrameBudget  time.Duration  `json:"frameBudget,omitempty"`
+}
+
+// PageState is the state of a single page in the StateDump.
+type PageState struct {
+	Key       string `json:"key"`
+	Title     string `json:"title"`
+	Locked    bool   `json:"locked,omitempty"`
+	Disabled  bool   `json:"disabled,omitempty"`
+	Dirty     bool   `json:"dirty,omitempty"`
+	Closable  bool   `json:"closable,omitempty"`
+	Icon      string `json:"icon,omitempty"`
+	Suffix    string `json:"suffix,omitempty"`
+	Badge     int    `json:"badge,omitempty"`
+	Workspace string `json:"workspace,omitempty"`
+	Model     string `json:"model"`
+}
+
+// TerminalReport describes the capabilities of the terminal the application runs in.
+type TerminalReport struct {
+	Width        int               `json:"width"`
+	Height       int               `json:"height"`
+	ColorProfile string            `json:"colorProfile"`
+	GlyphSupport string            `json:"glyphSupport"`
+	Multiplexer  string            `json:"multiplexer"`
+	Env          map[string]string `json:"env"`
+	GOOS         string            `json:"goos"`
+	GOARCH       string            `json:"goarch"`
+	GoVersion    string            `json:"goVersion"`
+}
+
+// supportBundleEnv are the environment variables describing the terminal, other variables are never included.
+var supportBundleEnv = []string{"TERM", "COLORTERM", "TERM_PROGRAM", "TERM_PROGRAM_VERSION", "LANG", "LC_ALL", "LC_CTYPE", glyphSupportEnv}
+
+// DumpState returns a snapshot of the state of the Skeleton.
+func (s *Skeleton) DumpState() StateDump {
+	dump := StateDump{
+		ActivePage:   s.GetActivePage(),
+		ClosedPages:  s.GetClosedPageKeys(),
+		ModalOpen:    s.IsModalOpen(),
+		HelpVisible:  s.helpVisible,
+		TabsLocked:   s.header.GetLockTabs(),
+		Theme:        s.GetTheme(),
+		Workspace:    s.GetActiveWorkspace(),
+		Themes:       s.GetThemes(),
+		MouseEnabled: s.IsMouseEnabled(),
+		ScreenReader: s.IsScreenReaderMode(),
+		FrameTiming:  s.GetFrameTiming(),
+		FrameBudget:  s.GetFrameBudget(),
+	}
+
+	for i, hdr := range s.header.headers {
+		page := PageState{
+			Key:       hdr.key,
+			Title:     hdr.title,
+			Locked:    s.IsTabLocked(hdr.key),
+			Disabled:  s.IsTabDisabled(hdr.key),
+			Dirty:     s.IsPageDirty(hdr.key),
+			Closable:  s.IsTabClosable(hdr.key),
+			Icon:      s.GetTabIcon(hdr.key),
+			Suffix:    s.GetTabSuffix(hdr.key),
+			Badge:     s.GetTabBadge(hdr.key),
+			Workspace: s.GetTabWorkspace(hdr.key),
+		}
+		if i < len(s.pages) {
+			page.Model = fmt.Sprintf("%T", s.pages[i])
+		}
+		dump.Pages = append(dump.Pages, page)
+	}
+
+	for _, wgt := range s.widget.widgets {
+		dump.Widgets = append(dump.Widgets, WidgetLayout{Key: wgt.Key, Value: wgt.Value})
+	}
+
+	return dump
+}
+
+// TerminalReport returns the capabilities of the terminal the application runs in.
+func (s *Skeleton) TerminalReport() TerminalReport {
+	report := TerminalReport{
+		Width:        s.viewport.Width,
+		Height:       s.viewport.Height,
+		ColorProfile: colorProfileName(lipgloss.ColorProfile()),
+		GlyphSupport: glyphSupportName(detectGlyphSupport()),
+		Multiplexer:  multiplexerName(s.GetMultiplexer()),
+		Env:          make(map[string]string),
+		GOOS:         runtime.GOOS,
+		GOARCH:       runtime.GOARCH,
+		GoVersion:    runtime.Version(),
+	}
+	for _, env := range supportBundleEnv {
+		if value, ok := os.LookupEnv(env); ok {
+			report.Env[env] = value
+		}
+	}
+	return report
+}
+
+// WriteSupportBundle writes a zip archive to attach to bug reports. It contains the state dump, the recent
+// message log, the layout with the theme and key bindings, and the terminal capability report.
+func (s *Skeleton) WriteSupportBundle(w io.Writer) error {
+	config := struct {
+		Layout      Layout              `json:"layout"`
+		Theme       string              `json:"theme,omitempty"`
+		KeyBindings map[string][]string `json:"keyBindings"`
+	}{
+		Layout:      s.ExportLayout(),
+		Theme:       s.GetTheme(),
+		KeyBindings: s.KeyMap.KeyBindings(),
+	}
+
+	files := []struct {
+		name string
+		data any
+	}{
+		{name: "state.json", data: s.DumpState()},
+		{name: "messages.json", data: s.GetMessageLog()},
+		{name: "config.json", data: config},
+		{name: "terminal.json", data: s.TerminalReport()},
+	}
+
+	archive := zip.NewWriter(w)
+	for _, file := range files {
+		fw, err := archive.Create(file.name)
+		if err != nil {
+			return fmt.Errorf("skeleton: write support bundle: %w", err)
+		}
+		encoder := json.NewEncoder(fw)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(file.data); err != nil {
+			return fmt.Errorf("skeleton: write support bundle %s: %w", file.name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("skeleton: write support bundle: %w", err)
+	}
+	return nil
+}
+
+// colorProfileName returns the name of the given color profile.
+func colorProfileName(profile termenv.Profile) string {
+	switch profile {
+	case termenv.TrueColor:
+		return "truecolor"
+	case termenv.ANSI256:
+		return "ansi256"
+	case termenv.ANSI:
+		return "ansi"
+	default:
+		return "ascii"
+	}
+}
+
+// multiplexerName returns the name of the given multiplexer.
+func multiplexerName(multiplexer Multiplexer) string {
+	switch multiplexer {
+	case MultiplexerTmux:
+		return "tmux"
+	case MultiplexerScreen:
+		return "screen"
+	default:
+		return "none"
+	}
+}
+
+// glyphSupportName returns the name of the given glyph support.
+func glyphSupportName(support GlyphSupport) string {
+	switch support {
+	case GlyphSupportASCII:
+		return "ascii"
+	case GlyphSupportUnicode:
+		return "unicode"
+	case GlyphSupportNerdFont:
+		return "nerdfont"
+	default:
+		return "auto"
+	}
+}
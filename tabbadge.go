@@ -0,0 +1,39 @@
+package skeleton
+
+import (
+	"strconv"
+)
+
+// SetTabBadge sets the count rendered after the title of the tab by the given key, e.g. "News (12)".
+// The badge is cleared when the tab is activated, so it is ignored for the active tab. Zero clears it.
+func (s *Skeleton) SetTabBadge(key string, count int) *Skeleton {
+	key = s.normalizeKey(key)
+	if count <= 0 || key == s.GetActivePage() {
+		delete(s.header.tabBadges, key)
+	} else {
+		s.header.tabBadges[key] = count
+	}
+	s.header.calculateTitleLength()
+	s.updater.Update()
+	return s
+}
+
+// GetTabBadge returns the badge count of the tab by the given key.
+func (s *Skeleton) GetTabBadge(key string) int {
+	key = s.normalizeKey(key)
+	return s.header.tabBadges[key]
+}
+
+// ClearTabBadge clears the badge of the tab by the given key.
+func (s *Skeleton) ClearTabBadge(key string) *Skeleton {
+	return s.SetTabBadge(key, 0)
+}
+
+// badgeLabel returns the rendered badge of the tab, it is empty if the tab has no badge.
+func (h *header) badgeLabel(key string) string {
+	count, ok := h.tabBadges[key]
+	if !ok {
+		return ""
+	}
+	return "(" + strconv.Itoa(count) + ")"
+}
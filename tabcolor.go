@@ -0,0 +1,46 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// tabColor is hold the border colors of a single tab, empty colors use the header colors.
+type tabColor struct {
+	active   string
+	inactive string
+}
+
+// SetTabColor sets the border colors of the tab by the given key, they are kept until the page is deleted.
+// An empty color uses the active or inactive tab border color of the header.
+func (s *Skeleton) SetTabColor(key string, activeColor string, inactiveColor string) *Skeleton {
+	key = s.normalizeKey(key)
+	if activeColor == "" && inactiveColor == "" {
+		delete(s.header.tabColors, key)
+	} else {
+		s.header.tabColors[key] = tabColor{active: activeColor, inactive: inactiveColor}
+	}
+	s.updater.Update()
+	return s
+}
+
+// GetTabColor returns the border colors of the tab by the given key, empty colors are not set.
+func (s *Skeleton) GetTabColor(key string) (activeColor string, inactiveColor string) {
+	key = s.normalizeKey(key)
+	color := s.header.tabColors[key]
+	return color.active, color.inactive
+}
+
+// tabStyle applies the color of the tab by the given key to the given style.
+func (h *header) tabStyle(style lipgloss.Style, key string, active bool) lipgloss.Style {
+	color, ok := h.tabColors[key]
+	if !ok {
+		return style
+	}
+	if active && color.active != "" {
+		return style.BorderForeground(lipgloss.Color(color.active))
+	}
+	if !active && color.inactive != "" {
+		return style.BorderForeground(lipgloss.Color(color.inactive))
+	}
+	return style
+}
@@ -0,0 +1,33 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SetTabDescription sets the description of the tab by the given key. The description of the active tab
+// is shown on the footer line while there is no status message. An empty description removes it.
+func (s *Skeleton) SetTabDescription(key string, description string) *Skeleton {
+	key = s.normalizeKey(key)
+	if description == "" {
+		delete(s.header.tabDescriptions, key)
+	} else {
+		s.header.tabDescriptions[key] = description
+	}
+	s.updater.Update()
+	return s
+}
+
+// GetTabDescription returns the description of the tab by the given key.
+func (s *Skeleton) GetTabDescription(key string) string {
+	key = s.normalizeKey(key)
+	return s.header.tabDescriptions[key]
+}
+
+// footerMessage returns the message shown on the footer line, the status message has priority over the description
+// unless it is shown on the status row.
+func (w *widget) footerMessage() (string, lipgloss.Style) {
+	if w.statusMessage != "" && !w.statusRow {
+		return w.statusMessage, lipgloss.NewStyle()
+	}
+	return w.description, lipgloss.NewStyle().Faint(true)
+}
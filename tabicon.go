@@ -0,0 +1,21 @@
+package skeleton
+
+// SetTabIcon sets a small icon (e.g. "●", "✓" or a spinner frame) which is rendered before the
+// title of the tab by the given key. An empty glyph removes it.
+func (s *Skeleton) SetTabIcon(key string, glyph string) *Skeleton {
+	key = s.normalizeKey(key)
+	if glyph == "" {
+		delete(s.header.tabIcons, key)
+	} else {
+		s.header.tabIcons[key] = glyph
+	}
+	s.header.calculateTitleLength()
+	s.updater.Update()
+	return s
+}
+
+// GetTabIcon returns the icon of the tab by the given key.
+func (s *Skeleton) GetTabIcon(key string) string {
+	key = s.normalizeKey(key)
+	return s.header.tabIcons[key]
+}
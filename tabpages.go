@@ -0,0 +1,117 @@
+package skeleton
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// isPaginated returns true if the tabs are split into pages of a fixed number of tabs.
+func (h *header) isPaginated() bool {
+	return h.properties.tabsPerPage > 0 && !h.isSidebar()
+}
+
+// tabPages returns the indexes of the visible tabs split into pages of tabsPerPage tabs.
+func (h *header) tabPages() [][]int {
+	var pages [][]int
+	var page []int
+	for i := range h.headers {
+		if !h.isVisible(i) {
+			continue
+		}
+		page = append(page, i)
+		if len(page) == h.properties.tabsPerPage {
+			pages = append(pages, page)
+			page = nil
+		}
+	}
+	if len(page) > 0 {
+		pages = append(pages, page)
+	}
+	return pages
+}
+
+// activeTabPage returns the index of the page which contains the active tab, the first one if none does.
+func (h *header) activeTabPage(pages [][]int) int {
+	for p, page := range pages {
+		for _, i := range page {
+			if i == h.currentTab {
+				return p
+			}
+		}
+	}
+	return 0
+}
+
+// tabPageIndicator renders the indicator of the shown page, e.g. " 2/5 ". It is empty for a single page.
+func (h *header) tabPageIndicator(page, count int) string {
+	if count <= 1 {
+		return ""
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(h.properties.borderColor)).Render(fmt.Sprintf(" %d/%d ", page+1, count))
+}
+
+// paginatedWidth returns the width of the widest page of tabs with its indicator.
+func (h *header) paginatedWidth() int {
+	pages := h.tabPages()
+	var width int
+	for p, page := range pages {
+		width = max(width, h.indexesWidth(page)+ansi.StringWidth(h.tabPageIndicator(p, len(pages))))
+	}
+	return width
+}
+
+// SetTabsPerPage splits the tabs into pages of the given number of tabs, only the page of the active tab is
+// shown with a "2/5" indicator. It is an alternative to SetScrollableTabs for very narrow terminals, the
+// pages are flipped by the next and previous tab page keys. Zero shows all the tabs again.
+func (s *Skeleton) SetTabsPerPage(count int) *Skeleton {
+	s.header.properties.tabsPerPage = max(count, 0)
+	s.updater.UpdateWithMsg(s.header.calculateTitleLength()())
+	return s
+}
+
+// GetTabsPerPage returns the number of tabs per page of the tab bar, zero if the tabs are not paginated.
+func (s *Skeleton) GetTabsPerPage() int {
+	return s.header.properties.tabsPerPage
+}
+
+// NextTabPage activates the first tab of the next page of the paginated tab bar which can be activated.
+// It returns true if the active tab is changed.
+func (s *Skeleton) NextTabPage() bool {
+	return s.flipTabPage(1)
+}
+
+// PrevTabPage activates the first tab of the previous page of the paginated tab bar which can be activated.
+// It returns true if the active tab is changed.
+func (s *Skeleton) PrevTabPage() bool {
+	return s.flipTabPage(-1)
+}
+
+// flipTabPage activates a tab of the page by the given distance from the shown one. The pages whose tabs
+// can not be activated are skipped, past the ends they wrap around if the tab switching does.
+func (s *Skeleton) flipTabPage(delta int) bool {
+	if !s.header.isPaginated() {
+		return false
+	}
+
+	pages := s.header.tabPages()
+	current := s.header.activeTabPage(pages)
+	for p := current + delta; p != current; p += delta {
+		if p < 0 || p >= len(pages) {
+			if !s.properties.wrapTabs {
+				return false
+			}
+			p = (p + len(pages)) % len(pages)
+			if p == current {
+				return false
+			}
+		}
+		for _, i := range pages[p] {
+			if s.JumpToTab(i) {
+				return true
+			}
+		}
+	}
+	return false
+}
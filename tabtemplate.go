@@ -0,0 +1,124 @@
+package skeleton
+
+import (
+	"strings"
+)
+
+// defaultTabTemplate is the template of the tab titles if it is not set by SetDefaultTabTemplate.
+const defaultTabTemplate = "{icon} {title} {suffix} {badge}"
+
+// SetTabSuffix sets a short segment (e.g. "*" for unsaved changes) which is rendered in the {suffix}
+// segment of the tab template, without changing the title. An empty suffix removes it.
+func (s *Skeleton) SetTabSuffix(key string, suffix string) *Skeleton {
+	key = s.normalizeKey(key)
+	if suffix == "" {
+		delete(s.header.tabSuffixes, key)
+	} else {
+		s.header.tabSuffixes[key] = suffix
+	}
+	s.header.calculateTitleLength()
+	s.updater.Update()
+	return s
+}
+
+// GetTabSuffix returns the suffix of the tab by the given key.
+func (s *Skeleton) GetTabSuffix(key string) string {
+	key = s.normalizeKey(key)
+	return s.header.tabSuffixes[key]
+}
+
+// SetTabTemplate sets the template of the tab by the given key. The template can contain the {icon},
+// {title}, {suffix} and {badge} segments, e.g. "{icon} {title}{suffix}". Empty segments are removed with
+// the space after them, so they can be updated independently. An empty template restores the default one.
+func (s *Skeleton) SetTabTemplate(key string, template string) *Skeleton {
+	key = s.normalizeKey(key)
+	if template == "" {
+		delete(s.header.tabTemplates, key)
+	} else {
+		s.header.tabTemplates[key] = template
+	}
+	s.header.calculateTitleLength()
+	s.updater.Update()
+	return s
+}
+
+// GetTabTemplate returns the template of the tab by the given key, the default template if it is not set.
+func (s *Skeleton) GetTabTemplate(key string) string {
+	key = s.normalizeKey(key)
+	return s.header.tabTemplate(key)
+}
+
+// SetDefaultTabTemplate sets the template of the tabs which have no template of their own, see SetTabTemplate.
+// An empty template restores "{icon} {title} {suffix} {badge}".
+func (s *Skeleton) SetDefaultTabTemplate(template string) *Skeleton {
+	if template == "" {
+		template = defaultTabTemplate
+	}
+	s.header.properties.tabTemplate = template
+	s.header.calculateTitleLength()
+	s.updater.Update()
+	return s
+}
+
+// GetDefaultTabTemplate returns the template of the tabs which have no template of their own.
+func (s *Skeleton) GetDefaultTabTemplate() string {
+	return s.header.properties.tabTemplate
+}
+
+// tabTemplate returns the template of the tab by the given key.
+func (h *header) tabTemplate(key string) string {
+	if template, ok := h.tabTemplates[key]; ok {
+		return template
+	}
+	return h.properties.tabTemplate
+}
+
+// tabLabel returns the tab rendered by its template, without the close glyph.
+func (h *header) tabLabel(hdr commonHeader) string {
+	return expandTabTemplate(h.tabTemplate(hdr.key), func(segment string) (string, bool) {
+		switch segment {
+		case "icon":
+			return h.tabIcons[hdr.key], true
+		case "title":
+			return h.displayTitle(hdr), true
+		case "suffix":
+			return h.tabSuffixes[hdr.key], true
+		case "badge":
+			return h.badgeLabel(hdr.key), true
+		}
+		return "", false
+	})
+}
+
+// expandTabTemplate replaces the segments of the template by their values. The space after an empty
+// segment is removed, unknown segments are kept as they are.
+func expandTabTemplate(template string, value func(segment string) (string, bool)) string {
+	var b strings.Builder
+	skipSpace := false
+	for {
+		start := strings.IndexByte(template, '{')
+		end := strings.IndexByte(template[max(start, 0):], '}')
+		if start < 0 || end < 0 {
+			if skipSpace {
+				template = strings.TrimPrefix(template, " ")
+			}
+			b.WriteString(template)
+			break
+		}
+
+		literal, segment := template[:start], template[start+1:start+end]
+		template = template[start+end+1:]
+		if skipSpace {
+			literal = strings.TrimPrefix(literal, " ")
+		}
+		b.WriteString(literal)
+
+		v, ok := value(segment)
+		if !ok {
+			v = "{" + segment + "}"
+		}
+		b.WriteString(v)
+		skipSpace = v == ""
+	}
+	return strings.TrimRight(b.String(), " ")
+}
@@ -0,0 +1,118 @@
+package skeleton
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Theme is hold the colors of the Skeleton. Empty colors are left unchanged when the theme is applied.
+type Theme struct {
+	Name                   string
+	BorderColor            string
+	ActiveTabTextColor     string
+	ActiveTabBorderColor   string
+	InactiveTabTextColor   string
+	InactiveTabBorderColor string
+	WidgetBorderColor      string
+}
+
+// ThemeChangedMsg is sent to all pages when the theme is changed.
+type ThemeChangedMsg struct {
+	// Name is the name of the applied theme
+	Name string
+}
+
+// broadcastMsg is hold a message which is delivered to all pages, not only the active one.
+type broadcastMsg struct {
+	msg tea.Msg
+}
+
+// broadcast delivers the given message to all pages. It does not block, so it can be called on the update
+// loop, but the message is dropped when the buffer of the updater is full.
+func (s *Skeleton) broadcast(msg tea.Msg) {
+	s.updater.UpdateWithMsg(broadcastMsg{msg: msg})
+}
+
+// updateAllPages delivers the message to all pages.
+func (s *Skeleton) updateAllPages(msg tea.Msg) []tea.Cmd {
+	cmds := make([]tea.Cmd, 0, len(s.pages))
+	for i := range s.pages {
+		cmds = append(cmds, s.updatePageAt(i, msg))
+	}
+	return cmds
+}
+
+// RegisterTheme registers the given theme, a theme with the same name is replaced.
+func (s *Skeleton) RegisterTheme(theme Theme) *Skeleton {
+	for i := range s.themes {
+		if s.themes[i].Name == theme.Name {
+			s.themes[i] = theme
+			return s
+		}
+	}
+	s.themes = append(s.themes, theme)
+	return s
+}
+
+// SetTheme applies the registered theme by the given name. It returns false if there is no such theme.
+func (s *Skeleton) SetTheme(name string) bool {
+	for i, theme := range s.themes {
+		if theme.Name == name {
+			s.applyTheme(i)
+			return true
+		}
+	}
+	return false
+}
+
+// NextTheme applies the next registered theme, it wraps around after the last one.
+func (s *Skeleton) NextTheme() *Skeleton {
+	if len(s.themes) == 0 {
+		return s
+	}
+	s.applyTheme((s.currentTheme + 1) % len(s.themes))
+	return s
+}
+
+// GetTheme returns the name of the applied theme, or empty string if no theme is applied.
+func (s *Skeleton) GetTheme() string {
+	if s.currentTheme < 0 || s.currentTheme >= len(s.themes) {
+		return ""
+	}
+	return s.themes[s.currentTheme].Name
+}
+
+// GetThemes returns the names of the registered themes.
+func (s *Skeleton) GetThemes() []string {
+	names := make([]string, len(s.themes))
+	for i, theme := range s.themes {
+		names[i] = theme.Name
+	}
+	return names
+}
+
+// applyTheme applies the registered theme at the given index.
+func (s *Skeleton) applyTheme(index int) {
+	theme := s.themes[index]
+	s.currentTheme = index
+
+	if theme.BorderColor != "" {
+		s.SetBorderColor(theme.BorderColor)
+	}
+	if theme.ActiveTabTextColor != "" {
+		s.SetActiveTabTextColor(theme.ActiveTabTextColor)
+	}
+	if theme.ActiveTabBorderColor != "" {
+		s.SetActiveTabBorderColor(theme.ActiveTabBorderColor)
+	}
+	if theme.InactiveTabTextColor != "" {
+		s.SetInactiveTabTextColor(theme.InactiveTabTextColor)
+	}
+	if theme.InactiveTabBorderColor != "" {
+		s.SetInactiveTabBorderColor(theme.InactiveTabBorderColor)
+	}
+	if theme.WidgetBorderColor != "" {
+		s.SetWidgetBorderColor(theme.WidgetBorderColor)
+	}
+
+	s.broadcast(ThemeChangedMsg{Name: theme.Name})
+}
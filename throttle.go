@@ -0,0 +1,125 @@
+package skeleton
+
+import (
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// throttler limits how often a keyed message is sent. Messages that arrive
+// inside the interval are coalesced and only the latest one is sent when the
+// interval elapses.
+type throttler struct {
+	mu      sync.Mutex
+	entries map[string]*throttleEntry
+	clock   Clock
+}
+
+// throttleEntry is hold the state of a throttled key, it exists while the interval of the key runs.
+type throttleEntry struct {
+	interval time.Duration
+	pending  func(wait bool) bool
+}
+
+// newThrottler returns a new throttler.
+func newThrottler() *throttler {
+	return &throttler{
+		entries: make(map[string]*throttleEntry),
+		clock:   SystemClock(),
+	}
+}
+
+// do sends immediately if the interval of the key is not running, otherwise it keeps send to be called
+// once the interval elapses. send must not block when wait is false and returns true if it sent, a send
+// which could not be done immediately is done when the interval elapses, waiting for room then.
+func (t *throttler) do(key string, minInterval time.Duration, send func(wait bool) bool) {
+	t.mu.Lock()
+
+	// keep only the latest value, older ones are outdated anyway
+	if e, ok := t.entries[key]; ok {
+		e.pending = send
+		t.mu.Unlock()
+		return
+	}
+
+	sent := send(false)
+	if sent && minInterval <= 0 {
+		t.mu.Unlock()
+		return
+	}
+
+	e := &throttleEntry{interval: minInterval}
+	if !sent {
+		e.pending = send
+	}
+	t.entries[key] = e
+	clock := t.clock
+	t.mu.Unlock()
+
+	// the clock may call flush synchronously, so the lock is released first
+	clock.AfterFunc(minInterval, func() {
+		t.flush(key)
+	})
+}
+
+// flush sends the pending message of the given key and starts its interval again, the key is removed
+// when there is nothing pending.
+func (t *throttler) flush(key string) {
+	t.mu.Lock()
+	e, ok := t.entries[key]
+	if !ok {
+		t.mu.Unlock()
+		return
+	}
+
+	send := e.pending
+	e.pending = nil
+	if send == nil {
+		delete(t.entries, key)
+		t.mu.Unlock()
+		return
+	}
+	clock := t.clock
+	t.mu.Unlock()
+
+	// the calls in the meantime become pending, they are sent after this one
+	send(true)
+
+	clock.AfterFunc(e.interval, func() {
+		t.flush(key)
+	})
+}
+
+// throttledSend returns the send function of msg for the throttler, it sends msg through the updater and
+// waits for room in the buffer when wait is true, until the Skeleton shuts down.
+func (s *Skeleton) throttledSend(msg any) func(wait bool) bool {
+	return func(wait bool) bool {
+		if wait {
+			return s.updater.sendWithMsg(s.ctx, msg)
+		}
+		return s.updater.tryUpdateWithMsg(msg)
+	}
+}
+
+// UpdateWidgetValueThrottled updates the widget value by the given key, but not more often than minInterval.
+// Updates that arrive faster are coalesced and the latest value is applied when the interval elapses.
+// The value is applied on the update loop, so it can be called from other goroutines.
+func (s *Skeleton) UpdateWidgetValueThrottled(key string, value string, minInterval time.Duration) *Skeleton {
+	key = s.normalizeKey(key)
+	s.throttler.do("widget:"+key, minInterval, s.throttledSend(runMsg{fn: func() {
+		s.UpdateWidgetValue(key, value)
+	}}))
+	return s
+}
+
+// TriggerUpdateWithMsgThrottled sends the given message to the pages, but not more often than minInterval for the same key.
+// Messages that arrive faster are coalesced and only the latest one is sent when the interval elapses.
+func (s *Skeleton) TriggerUpdateWithMsgThrottled(key string, msg tea.Msg, minInterval time.Duration) {
+	s.throttler.do("msg:"+key, minInterval, s.throttledSend(msg))
+}
+
+// TriggerUpdateThrottled triggers an update, but not more often than minInterval for the same key.
+func (s *Skeleton) TriggerUpdateThrottled(key string, minInterval time.Duration) {
+	s.throttler.do("update:"+key, minInterval, s.throttledSend(UpdateMsgInstance))
+}
@@ -0,0 +1,45 @@
+package skeleton
+
+import (
+	"testing"
+	"time"
+)
+
+// syncClock is a Clock whose timers call their functions synchronously, before AfterFunc returns.
+type syncClock struct {
+	Clock
+}
+
+func (c syncClock) AfterFunc(d time.Duration, f func()) ClockTimer {
+	f()
+	return c.Clock.AfterFunc(d, func() {})
+}
+
+func TestThrottlerWithSynchronousClock(t *testing.T) {
+	for _, sent := range []bool{true, false} {
+		th := newThrottler()
+		th.clock = syncClock{Clock: SystemClock()}
+
+		calls := 0
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			th.do("key", time.Second, func(bool) bool {
+				calls++
+				return sent
+			})
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("throttler is deadlocked when the first send returns %v", sent)
+		}
+		if want := map[bool]int{true: 1, false: 2}[sent]; calls != want {
+			t.Errorf("send is called %d times when it returns %v, want %d", calls, sent, want)
+		}
+		if len(th.entries) != 0 {
+			t.Errorf("%d keys are left after the intervals elapsed", len(th.entries))
+		}
+	}
+}
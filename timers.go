@@ -0,0 +1,157 @@
+package skeleton
+
+import (
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// pageMsg is hold a message which is delivered to a specific page, even if it is not active.
+type pageMsg struct {
+	key string
+	msg tea.Msg
+}
+
+// pageTimers is hold the running timers and tickers of the pages by their keys.
+type pageTimers struct {
+	mu     sync.Mutex
+	nextID int
+	timers map[string]map[int]func() bool
+}
+
+// newPageTimers returns a new pageTimers.
+func newPageTimers() *pageTimers {
+	return &pageTimers{
+		timers: make(map[string]map[int]func() bool),
+	}
+}
+
+// reserve registers a new timer for the given page and returns its id, its stop function is set by start.
+func (t *pageTimers) reserve(key string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.nextID++
+	if t.timers[key] == nil {
+		t.timers[key] = make(map[int]func() bool)
+	}
+	t.timers[key][t.nextID] = nil
+	return t.nextID
+}
+
+// start sets the stop function of the reserved timer. It returns false if the timer fired or was cancelled
+// in the meantime, the caller has to stop it then.
+func (t *pageTimers) start(key string, id int, stop func() bool) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.timers[key][id]; !ok {
+		return false
+	}
+	t.timers[key][id] = stop
+	return true
+}
+
+// add registers the stop function of a timer for the given page.
+func (t *pageTimers) add(key string, stop func() bool) {
+	if !t.start(key, t.reserve(key), stop) {
+		stop()
+	}
+}
+
+// remove forgets the timer by the given id of the given page, it is called when a timer fires.
+func (t *pageTimers) remove(key string, id int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.timers[key], id)
+	if len(t.timers[key]) == 0 {
+		delete(t.timers, key)
+	}
+}
+
+// cancel stops all the timers of the given page.
+func (t *pageTimers) cancel(key string) {
+	t.mu.Lock()
+	stops := t.timers[key]
+	delete(t.timers, key)
+	t.mu.Unlock()
+
+	for _, stop := range stops {
+		if stop != nil {
+			stop()
+		}
+	}
+}
+
+// After sends msg to the page by the given key once d elapses.
+// The timer is cancelled automatically when the page is deleted.
+func (s *Skeleton) After(key string, d time.Duration, msg tea.Msg) *Skeleton {
+	key = s.normalizeKey(key)
+	id := s.timers.reserve(key)
+	timer := s.clock.AfterFunc(d, func() {
+		defer s.restoreOnPanic()
+		s.timers.remove(key, id)
+		// the clock may call it on any goroutine, the update loop included
+		s.updater.queueWithMsg(s.ctx, pageMsg{key: key, msg: msg})
+	})
+	if !s.timers.start(key, id, timer.Stop) {
+		timer.Stop()
+	}
+	return s
+}
+
+// Ticker sends msg to the page by the given key every d until the page is deleted, CancelTimers is called
+// or the application shuts down. Non-positive durations are ignored.
+func (s *Skeleton) Ticker(key string, d time.Duration, msg tea.Msg) *Skeleton {
+	if d <= 0 {
+		return s
+	}
+
+	key = s.normalizeKey(key)
+	ctx := s.ctx
+	ticker := s.clock.NewTicker(d)
+	done := make(chan struct{})
+	var once sync.Once
+
+	go func() {
+		defer s.restoreOnPanic()
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C():
+				s.updater.sendWithMsg(ctx, pageMsg{key: key, msg: msg})
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	s.timers.add(key, func() bool {
+		once.Do(func() {
+			close(done)
+		})
+		return true
+	})
+	return s
+}
+
+// CancelTimers stops all the timers and tickers of the page by the given key.
+func (s *Skeleton) CancelTimers(key string) *Skeleton {
+	key = s.normalizeKey(key)
+	s.timers.cancel(key)
+	return s
+}
+
+// updatePage delivers the message to the page by the given key.
+func (s *Skeleton) updatePage(key string, msg tea.Msg) tea.Cmd {
+	index := s.pageIndex(key)
+	if index < 0 || index >= len(s.pages) {
+		return nil
+	}
+
+	return s.updatePageAt(index, msg)
+}
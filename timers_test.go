@@ -0,0 +1,109 @@
+package skeleton
+
+import (
+	"testing"
+	"time"
+)
+
+// timerCount returns the number of the registered timers of the page by the given key.
+func timerCount(s *Skeleton, key string) int {
+	s.timers.mu.Lock()
+	defer s.timers.mu.Unlock()
+	return len(s.timers.timers[key])
+}
+
+func TestAfterForgetsFiredTimers(t *testing.T) {
+	clock := NewSimulatedClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	s := NewSkeleton().SetClock(clock)
+	s.AddPage("page", "Page", newTestPage())
+
+	for range 3 {
+		s.After("page", time.Second, testMsg{n: 1})
+	}
+	s.After("page", time.Minute, testMsg{n: 2})
+	if got := timerCount(s, "page"); got != 4 {
+		t.Fatalf("%d timers registered, want 4", got)
+	}
+
+	clock.Advance(time.Second)
+	if got := timerCount(s, "page"); got != 1 {
+		t.Errorf("%d timers registered after they fired, want 1", got)
+	}
+
+	clock.Advance(time.Minute)
+	s.timers.mu.Lock()
+	_, ok := s.timers.timers["page"]
+	s.timers.mu.Unlock()
+	if ok {
+		t.Error("the page keeps its timers after all of them fired")
+	}
+}
+
+func TestTickerIgnoresNonPositiveDurations(t *testing.T) {
+	s := NewSkeleton()
+	s.AddPage("page", "Page", newTestPage())
+
+	s.Ticker("page", 0, testMsg{}).Ticker("page", -time.Second, testMsg{})
+	if got := timerCount(s, "page"); got != 0 {
+		t.Errorf("%d tickers registered, want 0", got)
+	}
+}
+
+func TestTickerStopsOnShutdown(t *testing.T) {
+	clock := NewSimulatedClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	s := NewSkeleton().SetClock(clock)
+	s.AddPage("page", "Page", newTestPage())
+
+	s.Ticker("page", time.Second, testMsg{})
+	s.Shutdown()
+
+	// the goroutine of the ticker stops the ticker when it returns
+	waitForIdleClock(t, clock)
+}
+
+// waitForIdleClock waits until no timer or ticker is scheduled on the clock.
+func waitForIdleClock(t *testing.T, clock *SimulatedClock) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		clock.mu.Lock()
+		events := len(clock.events)
+		clock.mu.Unlock()
+		if events == 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d timers and tickers are still scheduled", events)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestAfterDoesNotDropMessage(t *testing.T) {
+	s, clock := newFullSkeleton(t)
+	page := newTestPage()
+	s.AddPage("page", "Page", page)
+
+	s.After("page", time.Second, testMsg{n: 1})
+	clock.Advance(time.Second)
+	runTestProgram(t, s)
+
+	if msg := receive(t, page); msg.n != 1 {
+		t.Errorf("page received %d, want 1", msg.n)
+	}
+}
+
+func TestTickerDoesNotDropMessage(t *testing.T) {
+	s, clock := newFullSkeleton(t)
+	page := newTestPage()
+	s.AddPage("page", "Page", page)
+
+	s.Ticker("page", time.Second, testMsg{n: 1})
+	clock.Advance(time.Second)
+	runTestProgram(t, s)
+
+	if msg := receive(t, page); msg.n != 1 {
+		t.Errorf("page received %d, want 1", msg.n)
+	}
+}
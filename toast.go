@@ -0,0 +1,106 @@
+package skeleton
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// NotifyLevel is the severity of a toast notification.
+type NotifyLevel int
+
+const (
+	NotifyInfo NotifyLevel = iota
+	NotifySuccess
+	NotifyWarning
+	NotifyError
+)
+
+// maxVisibleToasts is the maximum number of toasts shown at the same time.
+const maxVisibleToasts = 3
+
+// color returns the border color of the level.
+func (l NotifyLevel) color() string {
+	switch l {
+	case NotifySuccess:
+		return "42"
+	case NotifyWarning:
+		return "214"
+	case NotifyError:
+		return "196"
+	default:
+		return "39"
+	}
+}
+
+// toast is hold a single toast notification.
+type toast struct {
+	id    int
+	level NotifyLevel
+	text  string
+}
+
+// toastMsg adds a toast, it is sent through the updater to keep Notify safe for goroutines.
+type toastMsg struct {
+	toast    toast
+	duration time.Duration
+}
+
+// toastExpiredMsg removes the toast by the given id.
+type toastExpiredMsg struct {
+	id int
+}
+
+// Notify shows a transient toast notification in the top right corner of the page body.
+// The toast is dismissed automatically after the given duration.
+func (s *Skeleton) Notify(level NotifyLevel, text string, duration time.Duration) *Skeleton {
+	s.toastMu.Lock()
+	s.lastToastID++
+	id := s.lastToastID
+	s.toastMu.Unlock()
+
+	// the toast waits for room in the updater instead of being dropped, it may be notified on the update loop too
+	s.updater.queueWithMsg(s.ctx, toastMsg{
+		toast:    toast{id: id, level: level, text: text},
+		duration: duration,
+	})
+	return s
+}
+
+// addToast shows the given toast and returns the command which expires it. The expiry is a command
+// rather than an update, so it can not be dropped when the updater is busy.
+func (s *Skeleton) addToast(msg toastMsg) tea.Cmd {
+	s.toasts = append(s.toasts, msg.toast)
+	return s.clockTick(msg.duration, toastExpiredMsg{id: msg.toast.id})
+}
+
+// removeToast removes the toast by the given id.
+func (s *Skeleton) removeToast(id int) {
+	for i, t := range s.toasts {
+		if t.id == id {
+			s.toasts = append(s.toasts[:i], s.toasts[i+1:]...)
+			return
+		}
+	}
+}
+
+// toastsView renders the newest toasts stacked vertically.
+func (s *Skeleton) toastsView(maxWidth int) string {
+	toasts := s.toasts
+	if len(toasts) > maxVisibleToasts {
+		toasts = toasts[len(toasts)-maxVisibleToasts:]
+	}
+
+	rendered := make([]string, len(toasts))
+	for i, t := range toasts {
+		rendered[i] = lipgloss.NewStyle().
+			Border(s.properties.glyphs.Frame).
+			BorderForeground(lipgloss.Color(t.level.color())).
+			Padding(0, 1).
+			MaxWidth(maxWidth).
+			Render(t.text)
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Right, rendered...)
+}
@@ -0,0 +1,16 @@
+package skeleton
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNotifyDoesNotDropToast(t *testing.T) {
+	s, _ := newFullSkeleton(t)
+	s.Notify(NotifyInfo, "saved", time.Minute)
+	p := runTestProgram(t, s)
+
+	eventually(t, p, func() bool {
+		return len(s.toasts) == 1 && s.toasts[0].text == "saved"
+	})
+}
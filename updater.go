@@ -1,8 +1,10 @@
 package skeleton
 
 import (
-	tea "github.com/charmbracelet/bubbletea"
+	"context"
 	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 type Updater struct {
@@ -11,19 +13,12 @@ type Updater struct {
 	mu        sync.Mutex
 }
 
-var (
-	updaterInstance *Updater
-	onceUpdater     sync.Once
-)
-
+// NewUpdater returns a new Updater. Every Skeleton owns its updater, so the Skeletons of different
+// sessions do not receive the updates of each other.
 func NewUpdater() *Updater {
-	onceUpdater.Do(func() {
-		updaterInstance = &Updater{
-			rcv: make(chan any, 256), // 256 is a reasonable buffer size for most cases, but it depends on your application's needs.
-		}
-	})
-
-	return updaterInstance
+	return &Updater{
+		rcv: make(chan any, 256), // 256 is a reasonable buffer size for most cases, but it depends on your application's needs.
+	}
 }
 
 type UpdateMsg struct{}
@@ -62,11 +57,34 @@ func (u *Updater) Update() {
 }
 
 func (u *Updater) UpdateWithMsg(msg any) {
-	// Add non-blocking send
+	u.tryUpdateWithMsg(msg)
+}
+
+// tryUpdateWithMsg sends the message if there is room in the buffer and returns true if it was sent.
+func (u *Updater) tryUpdateWithMsg(msg any) bool {
 	select {
 	case u.rcv <- msg:
-		// Successfully sent
+		return true
 	default:
-		// Channel is full, skip update
+		return false
+	}
+}
+
+// sendWithMsg sends the message, it waits for room in the buffer instead of dropping the message, until
+// ctx is done. It must not be called on the update loop, which is the one making room.
+func (u *Updater) sendWithMsg(ctx context.Context, msg any) bool {
+	select {
+	case u.rcv <- msg:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// queueWithMsg sends the message without dropping it. If the buffer is full, the message waits for room in
+// its own goroutine until ctx is done, so unlike sendWithMsg it can be called on the update loop too.
+func (u *Updater) queueWithMsg(ctx context.Context, msg any) {
+	if !u.tryUpdateWithMsg(msg) {
+		go u.sendWithMsg(ctx, msg)
 	}
 }
@@ -1,61 +1,55 @@
 package skeleton
 
 import (
-	"sync"
-
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/lipgloss"
 )
 
 // --------------------------------------------
 
-var (
-	onceViewport sync.Once
-	vp           *viewport.Model
-)
-
+// newTerminalViewport returns the viewport of a new Skeleton, it is shared with its header and widget.
 func newTerminalViewport() *viewport.Model {
-	onceViewport.Do(func() {
-		vp = &viewport.Model{Width: 80, Height: 24} // Question: Is it best to use 80x24 as default?
-	})
-	return vp
+	return &viewport.Model{Width: 80, Height: 24} // Question: Is it best to use 80x24 as default?
 }
 
 // --------------------------------------------
 
 // GetTerminalViewport returns the viewport.
 func (s *Skeleton) GetTerminalViewport() *viewport.Model {
-	return vp
+	return s.viewport
 }
 
 // SetTerminalViewportWidth sets the width of the viewport.
 func (s *Skeleton) SetTerminalViewportWidth(width int) {
-	vp.Width = width
+	s.viewport.Width = width
 }
 
 // SetTerminalViewportHeight sets the height of the viewport.
 func (s *Skeleton) SetTerminalViewportHeight(height int) {
-	vp.Height = height
+	s.viewport.Height = height
 }
 
 // GetTerminalWidth returns the width of the terminal.
 func (s *Skeleton) GetTerminalWidth() int {
-	return vp.Width
+	return s.viewport.Width
 }
 
 // GetTerminalHeight returns the height of the terminal.
 func (s *Skeleton) GetTerminalHeight() int {
-	return vp.Height
+	return s.viewport.Height
 }
 
 // GetContentWidth returns the available width for content (terminal width minus borders).
 func (s *Skeleton) GetContentWidth() int {
-	return vp.Width - 2
+	if s.header.isSidebar() {
+		return s.viewport.Width - 2 - s.header.sidebarWidth() - 1
+	}
+	return s.viewport.Width - 2
 }
 
 // GetContentHeight returns the available height for content (terminal height minus header and widgets).
 func (s *Skeleton) GetContentHeight() int {
 	headerHeight := lipgloss.Height(s.header.View())
 	footerHeight := lipgloss.Height(s.widget.View())
-	return vp.Height - headerHeight - footerHeight
+	return s.viewport.Height - headerHeight - footerHeight - s.topBarHeight() - s.subtitleHeight() - s.widget.statusRowHeight()
 }
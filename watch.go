@@ -0,0 +1,165 @@
+package skeleton
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// defaultWatchInterval is the interval which the watched files are checked for changes at.
+const defaultWatchInterval = 500 * time.Millisecond
+
+// fileWatchers is hold the running watches of the files by their pages and paths.
+type fileWatchers struct {
+	mu       sync.Mutex
+	watches  map[watchKey]*fileWatch
+	interval time.Duration
+	clock    Clock
+}
+
+// watchKey identifies a watch by the page it delivers to, empty for all pages, and the watched path.
+type watchKey struct {
+	page string
+	path string
+}
+
+// fileWatch is hold the stop function of a running watch.
+type fileWatch struct {
+	stop func()
+}
+
+// newFileWatchers returns a new fileWatchers.
+func newFileWatchers() *fileWatchers {
+	return &fileWatchers{
+		watches:  make(map[watchKey]*fileWatch),
+		interval: defaultWatchInterval,
+		clock:    SystemClock(),
+	}
+}
+
+// watch polls the file by the given path and calls deliver with its contents when it changes, until it is
+// stopped or ctx is done. The contents are delivered once at the start if the file exists. The watch of the
+// same page and path is replaced, the other watches of the path keep running. The returned function stops
+// the watch and forgets it.
+func (w *fileWatchers) watch(ctx context.Context, page, path string, deliver func([]byte)) func() {
+	key := watchKey{page: page, path: path}
+	done := make(chan struct{})
+	var once sync.Once
+	watch := &fileWatch{stop: func() {
+		once.Do(func() { close(done) })
+	}}
+
+	w.mu.Lock()
+	replaced := w.watches[key]
+	w.watches[key] = watch
+	interval, clock := w.interval, w.clock
+	w.mu.Unlock()
+
+	if replaced != nil {
+		replaced.stop()
+	}
+
+	go func() {
+		ticker := clock.NewTicker(interval)
+		defer ticker.Stop()
+
+		var modTime time.Time
+		var size int64 = -1
+		for {
+			if info, err := os.Stat(path); err == nil && (!info.ModTime().Equal(modTime) || info.Size() != size) {
+				if data, err := os.ReadFile(path); err == nil {
+					modTime, size = info.ModTime(), info.Size()
+					deliver(data)
+				}
+			}
+
+			select {
+			case <-ticker.C():
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return func() {
+		w.mu.Lock()
+		if w.watches[key] == watch {
+			delete(w.watches, key)
+		}
+		w.mu.Unlock()
+		watch.stop()
+	}
+}
+
+// unwatch stops all the watches of the file by the given path.
+func (w *fileWatchers) unwatch(path string) {
+	w.mu.Lock()
+	var stops []func()
+	for key, watch := range w.watches {
+		if key.path == path {
+			stops = append(stops, watch.stop)
+			delete(w.watches, key)
+		}
+	}
+	w.mu.Unlock()
+
+	for _, stop := range stops {
+		stop()
+	}
+}
+
+// WatchFile watches the file by the given path and delivers the message created by msgFactory from its
+// contents to all pages, once at the start and then every time the file changes. Pages handle the message
+// type they are interested in. A missing or unreadable file is retried until it can be read.
+// If msgFactory returns nil, nothing is delivered. The watch stops when the application shuts down.
+func (s *Skeleton) WatchFile(path string, msgFactory func([]byte) tea.Msg) *Skeleton {
+	s.watchers.watch(s.ctx, "", path, func(data []byte) {
+		defer s.restoreOnPanic()
+		if msg := msgFactory(data); msg != nil {
+			s.sendBroadcast(s.ctx, msg)
+		}
+	})
+	return s
+}
+
+// WatchFileForPage is like WatchFile, but delivers the message only to the page by the given key.
+// The watch is stopped automatically when the page is deleted. The pages and WatchFile watch the same
+// path independently, only a second watch of the path for the same page replaces the first.
+func (s *Skeleton) WatchFileForPage(key, path string, msgFactory func([]byte) tea.Msg) *Skeleton {
+	key = s.normalizeKey(key)
+	stop := s.watchers.watch(s.ctx, key, path, func(data []byte) {
+		defer s.restoreOnPanic()
+		// the change is recorded before it is delivered, so the message waits for room instead of being dropped
+		if msg := msgFactory(data); msg != nil {
+			s.updater.sendWithMsg(s.ctx, pageMsg{key: key, msg: msg})
+		}
+	})
+	s.timers.add(key, func() bool {
+		stop()
+		return true
+	})
+	return s
+}
+
+// UnwatchFile stops all the watches of the file by the given path, the ones of WatchFileForPage too.
+func (s *Skeleton) UnwatchFile(path string) *Skeleton {
+	s.watchers.unwatch(path)
+	return s
+}
+
+// SetWatchInterval sets the interval which the watched files are checked for changes at.
+// It applies to the files watched after it is set.
+func (s *Skeleton) SetWatchInterval(interval time.Duration) *Skeleton {
+	if interval <= 0 {
+		interval = defaultWatchInterval
+	}
+	s.watchers.mu.Lock()
+	s.watchers.interval = interval
+	s.watchers.mu.Unlock()
+	return s
+}
@@ -0,0 +1,90 @@
+package skeleton
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// writeTestFile writes the contents to the file by the given name in a temporary directory and returns its path.
+func writeTestFile(t *testing.T, name, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestWatchFileStopsOnShutdown(t *testing.T) {
+	clock := NewSimulatedClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	s := NewSkeleton().SetClock(clock)
+	s.AddPage("page", "Page", newTestPage())
+	path := writeTestFile(t, "watched", "contents")
+
+	s.WatchFile(path, func([]byte) tea.Msg { return nil })
+	s.WatchFileForPage("page", path+".missing", func([]byte) tea.Msg { return nil })
+	s.Shutdown()
+
+	// the goroutines of the watches stop their tickers when they return
+	waitForIdleClock(t, clock)
+}
+
+func TestWatchFileForPageDoesNotDropChanges(t *testing.T) {
+	s, _ := newFullSkeleton(t)
+	page := newTestPage()
+	s.AddPage("log", "Log", page)
+	path := writeTestFile(t, "log", "first line")
+
+	s.WatchFileForPage("log", path, func(data []byte) tea.Msg {
+		return testMsg{n: len(data)}
+	})
+	runTestProgram(t, s)
+
+	if msg := receive(t, page); msg.n != len("first line") {
+		t.Errorf("page received %d bytes, want %d", msg.n, len("first line"))
+	}
+}
+
+func TestWatchFileForPageKeepsOtherWatchesOfPath(t *testing.T) {
+	s := NewSkeleton()
+	s.AddPage("first", "First", newTestPage())
+	s.AddPage("second", "Second", newTestPage())
+	path := writeTestFile(t, "watched", "contents")
+	t.Cleanup(s.Shutdown)
+
+	delivered := make(chan string, 3)
+	watch := func(name string) func([]byte) tea.Msg {
+		return func([]byte) tea.Msg {
+			delivered <- name
+			return nil
+		}
+	}
+	s.WatchFile(path, watch("all"))
+	s.WatchFileForPage("first", path, watch("first"))
+	s.WatchFileForPage("second", path, watch("second"))
+
+	got := map[string]bool{}
+	for len(got) < 3 {
+		select {
+		case name := <-delivered:
+			got[name] = true
+		case <-time.After(time.Second):
+			t.Fatalf("delivered to %v, want all three watches", got)
+		}
+	}
+
+	updateSync(s, DeletePageMsg{Key: "first"})
+	s.watchers.mu.Lock()
+	defer s.watchers.mu.Unlock()
+	if _, ok := s.watchers.watches[watchKey{page: "first", path: path}]; ok {
+		t.Error("watch of the deleted page is not forgotten")
+	}
+	if len(s.watchers.watches) != 2 {
+		t.Errorf("%d watches left, want 2", len(s.watchers.watches))
+	}
+}
@@ -0,0 +1,184 @@
+// Package web serves skeleton applications to xterm.js in the browser over a websocket,
+// so dashboards can be shared without a terminal.
+package web
+
+import (
+	_ "embed"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/termkit/skeleton"
+)
+
+//go:embed index.html
+var indexHTML []byte
+
+// clientMessage is a message sent by the browser. Input is the data typed into the terminal,
+// resize reports the size of the terminal in cells.
+type clientMessage struct {
+	Type string `json:"type"`
+	Data string `json:"data,omitempty"`
+	Cols int    `json:"cols,omitempty"`
+	Rows int    `json:"rows,omitempty"`
+}
+
+// Handler serves the xterm.js page and its websocket. Every connection runs its own application,
+// created by New, so the browsers do not share their state.
+type Handler struct {
+	// New returns the application of a new connection
+	New func() *skeleton.Skeleton
+
+	// Options are passed to the program of every connection, after the input and the output options
+	Options []tea.ProgramOption
+
+	// CheckOrigin returns true if the websocket request is allowed, nil allows the requests
+	// whose origin is the host of the request only
+	CheckOrigin func(r *http.Request) bool
+
+	// Permissions returns the capabilities of the session of the request, e.g. by its credentials.
+	// Nil keeps the permissions the application is created with
+	Permissions func(r *http.Request) skeleton.Permissions
+}
+
+// NewHandler returns a handler which runs the application created by the factory for every connection.
+func NewHandler(factory func() *skeleton.Skeleton, opts ...tea.ProgramOption) *Handler {
+	return &Handler{
+		New:     factory,
+		Options: opts,
+	}
+}
+
+// ListenAndServe serves the application created by the factory on the given address, e.g. ":8080".
+func ListenAndServe(addr string, factory func() *skeleton.Skeleton, opts ...tea.ProgramOption) error {
+	return http.ListenAndServe(addr, NewHandler(factory, opts...))
+}
+
+// ServeHTTP serves the websocket for the upgrade requests and the xterm.js page for the others.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, ok := accept(w, r, h.CheckOrigin)
+	if !ok {
+		return
+	}
+	defer conn.Close()
+	h.serve(conn, r)
+}
+
+// serve runs a new application on the connection until it quits or the browser disconnects.
+// The input is piped into the program, the resizes are delivered through the updater of the Skeleton.
+func (h *Handler) serve(conn *wsConn, r *http.Request) {
+	s := h.New()
+	if h.Permissions != nil {
+		s.SetPermissions(h.Permissions(r))
+	}
+	input, inputWriter := io.Pipe()
+	defer inputWriter.Close()
+
+	opts := append([]tea.ProgramOption{
+		tea.WithInput(input),
+		tea.WithOutput(conn),
+		tea.WithoutSignalHandler(),
+	}, h.Options...)
+	p := tea.NewProgram(s, opts...)
+	s.SetProgram(p).SetOutput(conn)
+	// the program is not run by Run, so the goroutines of the session are stopped here
+	defer s.Shutdown()
+
+	go func() {
+		defer p.Quit()
+		for {
+			data, err := conn.readMessage()
+			if err != nil {
+				return
+			}
+			var msg clientMessage
+			if err := json.Unmarshal(data, &msg); err != nil {
+				continue
+			}
+			switch msg.Type {
+			case "input":
+				if _, err := io.WriteString(inputWriter, msg.Data); err != nil {
+					return
+				}
+			case "resize":
+				s.Resize(msg.Cols, msg.Rows)
+			}
+		}
+	}()
+
+	_, _ = p.Run()
+}
+
+// MirrorHandler serves a read-only mirror of a running Skeleton, every browser sees the same application.
+// The input of the browsers is ignored, only the primary program of the Skeleton accepts input.
+type MirrorHandler struct {
+	// Skeleton is the mirrored application
+	Skeleton *skeleton.Skeleton
+
+	// CheckOrigin returns true if the websocket request is allowed, nil allows the requests
+	// whose origin is the host of the request only
+	CheckOrigin func(r *http.Request) bool
+}
+
+// NewMirrorHandler returns a handler which mirrors the given Skeleton to the browsers.
+func NewMirrorHandler(s *skeleton.Skeleton) *MirrorHandler {
+	return &MirrorHandler{Skeleton: s}
+}
+
+// ServeHTTP serves the mirror for the upgrade requests and the xterm.js page for the others.
+func (h *MirrorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, ok := accept(w, r, h.CheckOrigin)
+	if !ok {
+		return
+	}
+	defer conn.Close()
+
+	detach := h.Skeleton.AttachMirror(conn)
+	defer detach()
+
+	// drain the messages until the browser disconnects, the mirror is read-only
+	for {
+		if _, err := conn.readMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// accept serves the xterm.js page for the plain requests and upgrades the allowed websocket requests.
+// It returns false if the request is served or rejected.
+func accept(w http.ResponseWriter, r *http.Request, checkOrigin func(r *http.Request) bool) (*wsConn, bool) {
+	if !isWebsocketRequest(r) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(indexHTML)
+		return nil, false
+	}
+
+	if checkOrigin == nil {
+		checkOrigin = sameOrigin
+	}
+	if !checkOrigin(r) {
+		http.Error(w, "origin is not allowed", http.StatusForbidden)
+		return nil, false
+	}
+
+	conn, err := upgrade(w, r)
+	if err != nil {
+		return nil, false
+	}
+	return conn, true
+}
+
+// sameOrigin returns true if the request has no origin or its origin is the host of the request.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return u.Host == r.Host
+}
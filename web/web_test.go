@@ -0,0 +1,98 @@
+package web
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/termkit/skeleton"
+)
+
+// recordPage sends the key and the size messages it receives on its channel.
+type recordPage struct {
+	msgs chan tea.Msg
+}
+
+func (p recordPage) Init() tea.Cmd { return nil }
+
+func (p recordPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg.(type) {
+	case tea.KeyMsg, tea.WindowSizeMsg:
+		select {
+		case p.msgs <- msg:
+		default:
+		}
+	}
+	return p, nil
+}
+
+func (p recordPage) View() string { return "record page" }
+
+// waitFor waits for the first message on the channel which cond accepts.
+func waitFor(t *testing.T, msgs <-chan tea.Msg, cond func(tea.Msg) bool) {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case msg := <-msgs:
+			if cond(msg) {
+				return
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for the message")
+		}
+	}
+}
+
+func TestHandlerDeliversInputAndResize(t *testing.T) {
+	msgs := make(chan tea.Msg, 64)
+	handler := NewHandler(func() *skeleton.Skeleton {
+		s := skeleton.NewSkeleton()
+		s.AddPage("page", "Page", recordPage{msgs: msgs})
+		return s
+	})
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client := dial(t, server)
+
+	// the output of the program is read until the server closes the connection
+	closed := make(chan bool, 1)
+	go func() {
+		sawClose := false
+		for {
+			opcode, _, err := client.nextFrame()
+			if err != nil {
+				closed <- sawClose && errors.Is(err, io.EOF)
+				return
+			}
+			sawClose = sawClose || opcode == opClose
+		}
+	}()
+
+	client.writeMessage(t, `{"type":"resize","cols":100,"rows":30}`)
+	waitFor(t, msgs, func(msg tea.Msg) bool {
+		size, ok := msg.(tea.WindowSizeMsg)
+		return ok && size.Width > 0 && size.Height > 0
+	})
+
+	client.writeMessage(t, `{"type":"input","data":"x"}`)
+	waitFor(t, msgs, func(msg tea.Msg) bool {
+		key, ok := msg.(tea.KeyMsg)
+		return ok && key.String() == "x"
+	})
+
+	client.writeFrame(t, true, opClose, binary.BigEndian.AppendUint16(nil, 1000), true)
+	select {
+	case ok := <-closed:
+		if !ok {
+			t.Error("the server did not answer the close frame and close the connection")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the server did not close the connection")
+	}
+}
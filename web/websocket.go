@@ -0,0 +1,261 @@
+package web
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// websocketGUID is appended to the key of the handshake, see RFC 6455 section 1.3.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+// maxMessageSize is the largest message which is read from the browser.
+const maxMessageSize = 1 << 20
+
+// The opcodes of the websocket frames.
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xA
+)
+
+// maxControlPayload is the largest payload of a control frame, see RFC 6455 section 5.5.
+const maxControlPayload = 125
+
+// The status codes of the close frames sent on the protocol errors, see RFC 6455 section 7.4.1.
+const (
+	closeProtocolError = 1002
+	closeMessageTooBig = 1009
+)
+
+var (
+	// errMessageTooLarge is returned when the browser sends a message larger than maxMessageSize.
+	errMessageTooLarge = errors.New("web: websocket message is too large")
+
+	// errUnmaskedFrame is returned when the browser sends an unmasked frame, clients must mask all their frames.
+	errUnmaskedFrame = errors.New("web: websocket frame is not masked")
+
+	// errInvalidControlFrame is returned when the browser sends a fragmented control frame or one with a long payload.
+	errInvalidControlFrame = errors.New("web: websocket control frame is invalid")
+)
+
+// wsConn is a minimal server side websocket connection, it supports the frames xterm.js sends.
+type wsConn struct {
+	conn net.Conn
+	rw   *bufio.ReadWriter
+
+	// writeMu serializes the frames written by the program and the control frames
+	writeMu sync.Mutex
+}
+
+// isWebsocketRequest returns true if the request asks for a websocket upgrade.
+func isWebsocketRequest(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") &&
+		headerContains(r.Header, "Connection", "upgrade")
+}
+
+// headerContains returns true if the comma separated header contains the given token.
+func headerContains(header http.Header, name string, token string) bool {
+	for _, value := range header.Values(name) {
+		for _, part := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(part), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// upgrade completes the websocket handshake and takes over the connection of the request.
+func upgrade(w http.ResponseWriter, r *http.Request) (*wsConn, error) {
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if key == "" || r.Header.Get("Sec-WebSocket-Version") != "13" {
+		http.Error(w, "unsupported websocket request", http.StatusBadRequest)
+		return nil, errors.New("web: unsupported websocket request")
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "websocket is not supported", http.StatusInternalServerError)
+		return nil, errors.New("web: response writer can not be hijacked")
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, fmt.Errorf("web: hijack connection: %w", err)
+	}
+
+	sum := sha1.Sum([]byte(key + websocketGUID))
+	accept := base64.StdEncoding.EncodeToString(sum[:])
+	response := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + accept + "\r\n\r\n"
+	if _, err := rw.WriteString(response); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("web: write handshake: %w", err)
+	}
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("web: write handshake: %w", err)
+	}
+	return &wsConn{conn: conn, rw: rw}, nil
+}
+
+// readMessage reads the next text or binary message, the control frames are handled on the way.
+// It returns io.EOF when the browser closes the connection. On a protocol error the close frame
+// with its status code is sent, the connection is closed by the caller.
+func (c *wsConn) readMessage() ([]byte, error) {
+	message, err := c.readFragments()
+	if err != nil {
+		c.closeWithError(err)
+	}
+	return message, err
+}
+
+// readFragments reads the frames of the next text or binary message and joins their payloads.
+func (c *wsConn) readFragments() ([]byte, error) {
+	var message []byte
+	for {
+		fin, opcode, payload, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+
+		switch opcode {
+		case opClose:
+			_ = c.writeFrame(opClose, nil)
+			return nil, io.EOF
+		case opPing:
+			if err := c.writeFrame(opPong, payload); err != nil {
+				return nil, err
+			}
+			continue
+		case opPong:
+			continue
+		case opText, opBinary, opContinuation:
+			message = append(message, payload...)
+			if len(message) > maxMessageSize {
+				return nil, errMessageTooLarge
+			}
+			if fin {
+				return message, nil
+			}
+		default:
+			return nil, fmt.Errorf("web: unknown websocket opcode %d", opcode)
+		}
+	}
+}
+
+// readFrame reads a single frame and unmasks its payload. The frames of the browser must be masked.
+func (c *wsConn) readFrame() (fin bool, opcode byte, payload []byte, err error) {
+	var header [2]byte
+	if _, err = io.ReadFull(c.rw, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+	fin = header[0]&0x80 != 0
+	opcode = header[0] & 0x0F
+	masked := header[1]&0x80 != 0
+
+	length := uint64(header[1] & 0x7F)
+	switch length {
+	case 126:
+		var extended [2]byte
+		if _, err = io.ReadFull(c.rw, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(extended[:]))
+	case 127:
+		var extended [8]byte
+		if _, err = io.ReadFull(c.rw, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(extended[:])
+	}
+	if !masked {
+		return false, 0, nil, errUnmaskedFrame
+	}
+	if opcode >= opClose && (!fin || length > maxControlPayload) {
+		return false, 0, nil, errInvalidControlFrame
+	}
+	if length > maxMessageSize {
+		return false, 0, nil, errMessageTooLarge
+	}
+
+	var mask [4]byte
+	if _, err = io.ReadFull(c.rw, mask[:]); err != nil {
+		return false, 0, nil, err
+	}
+
+	payload = make([]byte, length)
+	if _, err = io.ReadFull(c.rw, payload); err != nil {
+		return false, 0, nil, err
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return fin, opcode, payload, nil
+}
+
+// closeWithError sends the close frame of the given protocol error, other errors are ignored.
+func (c *wsConn) closeWithError(err error) {
+	var code uint16
+	switch {
+	case errors.Is(err, errUnmaskedFrame), errors.Is(err, errInvalidControlFrame):
+		code = closeProtocolError
+	case errors.Is(err, errMessageTooLarge):
+		code = closeMessageTooBig
+	default:
+		return
+	}
+	_ = c.writeFrame(opClose, binary.BigEndian.AppendUint16(nil, code))
+}
+
+// writeFrame writes a single unmasked frame, servers never mask their frames.
+func (c *wsConn) writeFrame(opcode byte, payload []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	header := []byte{0x80 | opcode}
+	switch length := len(payload); {
+	case length < 126:
+		header = append(header, byte(length))
+	case length <= 0xFFFF:
+		header = append(header, 126)
+		header = binary.BigEndian.AppendUint16(header, uint16(length))
+	default:
+		header = append(header, 127)
+		header = binary.BigEndian.AppendUint64(header, uint64(length))
+	}
+
+	if _, err := c.rw.Write(header); err != nil {
+		return err
+	}
+	if _, err := c.rw.Write(payload); err != nil {
+		return err
+	}
+	return c.rw.Flush()
+}
+
+// Write sends the output of the program as a binary message, it implements io.Writer.
+func (c *wsConn) Write(p []byte) (int, error) {
+	if err := c.writeFrame(opBinary, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close closes the connection.
+func (c *wsConn) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,380 @@
+package web
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testKey and testAccept are the key of the handshake and its accept value from RFC 6455 section 1.3.
+const (
+	testKey    = "dGhlIHNhbXBsZSBub25jZQ=="
+	testAccept = "s3pPLMBiTxaQ9kYGzzhZRbK+xOo="
+)
+
+// testMask is the mask of the frames written by testClient.
+var testMask = [4]byte{0x37, 0xfa, 0x21, 0x3d}
+
+// testClient is the browser side of a websocket connection.
+type testClient struct {
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// dial opens a websocket connection to the given server and checks the response of the handshake.
+func dial(t *testing.T, server *httptest.Server) *testClient {
+	t.Helper()
+
+	conn, err := net.Dial("tcp", server.Listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	request := "GET / HTTP/1.1\r\n" +
+		"Host: " + server.Listener.Addr().String() + "\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: keep-alive, Upgrade\r\n" +
+		"Sec-WebSocket-Key: " + testKey + "\r\n" +
+		"Sec-WebSocket-Version: 13\r\n\r\n"
+	if _, err := io.WriteString(conn, request); err != nil {
+		t.Fatal(err)
+	}
+
+	r := bufio.NewReader(conn)
+	response, err := http.ReadResponse(r, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("handshake status is %d, want %d", response.StatusCode, http.StatusSwitchingProtocols)
+	}
+	if got := response.Header.Get("Sec-WebSocket-Accept"); got != testAccept {
+		t.Fatalf("accept is %q, want %q", got, testAccept)
+	}
+	return &testClient{conn: conn, r: r}
+}
+
+// writeFrame writes a single frame, masked like the browsers do unless masked is false.
+func (c *testClient) writeFrame(t *testing.T, fin bool, opcode byte, payload []byte, masked bool) {
+	t.Helper()
+
+	first := opcode
+	if fin {
+		first |= 0x80
+	}
+	header := []byte{first}
+	var maskBit byte
+	if masked {
+		maskBit = 0x80
+	}
+	switch length := len(payload); {
+	case length < 126:
+		header = append(header, maskBit|byte(length))
+	case length <= 0xFFFF:
+		header = append(header, maskBit|126)
+		header = binary.BigEndian.AppendUint16(header, uint16(length))
+	default:
+		header = append(header, maskBit|127)
+		header = binary.BigEndian.AppendUint64(header, uint64(length))
+	}
+
+	data := bytes.Clone(payload)
+	if masked {
+		header = append(header, testMask[:]...)
+		for i := range data {
+			data[i] ^= testMask[i%4]
+		}
+	}
+	if _, err := c.conn.Write(append(header, data...)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// writeMessage writes a single masked text frame.
+func (c *testClient) writeMessage(t *testing.T, message string) {
+	t.Helper()
+	c.writeFrame(t, true, opText, []byte(message), true)
+}
+
+// readFrame reads a single frame of the server, which must not be masked.
+func (c *testClient) readFrame(t *testing.T) (byte, []byte) {
+	t.Helper()
+
+	opcode, payload, err := c.nextFrame()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return opcode, payload
+}
+
+// nextFrame is like readFrame, but returns the error instead of failing the test.
+func (c *testClient) nextFrame() (byte, []byte, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	if header[0]&0x80 == 0 {
+		return 0, nil, errors.New("server sent a fragmented frame")
+	}
+	if header[1]&0x80 != 0 {
+		return 0, nil, errors.New("server sent a masked frame")
+	}
+
+	length := uint64(header[1] & 0x7F)
+	switch length {
+	case 126:
+		var extended [2]byte
+		if _, err := io.ReadFull(c.r, extended[:]); err != nil {
+			return 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(extended[:]))
+	case 127:
+		var extended [8]byte
+		if _, err := io.ReadFull(c.r, extended[:]); err != nil {
+			return 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(extended[:])
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.r, payload); err != nil {
+		return 0, nil, err
+	}
+	return header[0] & 0x0F, payload, nil
+}
+
+// expectClose reads the close frame of the server with the given status code, zero for no code,
+// and checks that the connection is closed afterwards.
+func (c *testClient) expectClose(t *testing.T, code uint16) {
+	t.Helper()
+
+	opcode, payload := c.readFrame(t)
+	if opcode != opClose {
+		t.Fatalf("opcode is %d, want the close frame", opcode)
+	}
+	if code != 0 && (len(payload) < 2 || binary.BigEndian.Uint16(payload) != code) {
+		t.Errorf("close payload is %v, want the status %d", payload, code)
+	}
+	if _, err := c.r.ReadByte(); !errors.Is(err, io.EOF) {
+		t.Errorf("connection is not closed after the close frame: %v", err)
+	}
+}
+
+// echoServer upgrades the requests and echoes the messages as text frames. The error which ends
+// the connection is sent on the returned channel.
+func echoServer(t *testing.T) (*httptest.Server, <-chan error) {
+	t.Helper()
+
+	errs := make(chan error, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrade(w, r)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			message, err := conn.readMessage()
+			if err != nil {
+				errs <- err
+				return
+			}
+			if err := conn.writeFrame(opText, message); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, errs
+}
+
+// expectError waits for the error which ended the connection of the echo server.
+func expectError(t *testing.T, errs <-chan error, want error) {
+	t.Helper()
+
+	select {
+	case err := <-errs:
+		if !errors.Is(err, want) {
+			t.Errorf("connection ended with %v, want %v", err, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("connection did not end")
+	}
+}
+
+func TestUpgradeRejectsUnsupportedRequests(t *testing.T) {
+	server, _ := echoServer(t)
+
+	tests := []struct {
+		name    string
+		key     string
+		version string
+	}{
+		{name: "missing key", version: "13"},
+		{name: "old version", key: testKey, version: "8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			request.Header.Set("Upgrade", "websocket")
+			request.Header.Set("Connection", "Upgrade")
+			request.Header.Set("Sec-WebSocket-Key", tt.key)
+			request.Header.Set("Sec-WebSocket-Version", tt.version)
+
+			response, err := http.DefaultClient.Do(request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			response.Body.Close()
+			if response.StatusCode != http.StatusBadRequest {
+				t.Errorf("status is %d, want %d", response.StatusCode, http.StatusBadRequest)
+			}
+		})
+	}
+}
+
+func TestReadMessageUnmasksPayloadLengths(t *testing.T) {
+	server, _ := echoServer(t)
+	client := dial(t, server)
+
+	// the lengths of the short, the 16 bit and the 64 bit length encodings
+	for _, length := range []int{0, 5, 125, 126, 300, 0xFFFF, 0x10000, 70000} {
+		message := strings.Repeat("0123456789", length/10+1)[:length]
+		client.writeMessage(t, message)
+		opcode, payload := client.readFrame(t)
+		if opcode != opText || string(payload) != message {
+			t.Errorf("echo of %d bytes is %d bytes with the opcode %d", length, len(payload), opcode)
+		}
+	}
+}
+
+func TestReadMessageJoinsFragmentsAroundPing(t *testing.T) {
+	server, _ := echoServer(t)
+	client := dial(t, server)
+
+	client.writeFrame(t, false, opText, []byte("hel"), true)
+	client.writeFrame(t, true, opPing, []byte("ping"), true)
+	client.writeFrame(t, false, opContinuation, []byte("lo "), true)
+	client.writeFrame(t, true, opContinuation, []byte("world"), true)
+
+	// the ping between the fragments is answered right away, before the message is complete
+	if opcode, payload := client.readFrame(t); opcode != opPong || string(payload) != "ping" {
+		t.Errorf("got the opcode %d with %q, want the pong of the ping", opcode, payload)
+	}
+	if opcode, payload := client.readFrame(t); opcode != opText || string(payload) != "hello world" {
+		t.Errorf("got the opcode %d with %q, want the joined message", opcode, payload)
+	}
+}
+
+func TestReadMessageIgnoresPong(t *testing.T) {
+	server, _ := echoServer(t)
+	client := dial(t, server)
+
+	client.writeFrame(t, true, opPong, []byte("unsolicited"), true)
+	client.writeMessage(t, "after")
+	if opcode, payload := client.readFrame(t); opcode != opText || string(payload) != "after" {
+		t.Errorf("got the opcode %d with %q, want the message after the pong", opcode, payload)
+	}
+}
+
+func TestReadMessageAnswersClose(t *testing.T) {
+	server, errs := echoServer(t)
+	client := dial(t, server)
+
+	client.writeFrame(t, true, opClose, binary.BigEndian.AppendUint16(nil, 1000), true)
+	client.expectClose(t, 0)
+	expectError(t, errs, io.EOF)
+}
+
+func TestReadMessageRejectsUnmaskedFrame(t *testing.T) {
+	server, errs := echoServer(t)
+	client := dial(t, server)
+
+	client.writeFrame(t, true, opText, []byte("unmasked"), false)
+	client.expectClose(t, closeProtocolError)
+	expectError(t, errs, errUnmaskedFrame)
+}
+
+func TestReadMessageRejectsInvalidControlFrames(t *testing.T) {
+	tests := []struct {
+		name    string
+		fin     bool
+		payload []byte
+	}{
+		{name: "fragmented", payload: []byte("ping")},
+		{name: "long payload", fin: true, payload: bytes.Repeat([]byte("x"), maxControlPayload+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, errs := echoServer(t)
+			client := dial(t, server)
+
+			client.writeFrame(t, tt.fin, opPing, tt.payload, true)
+			client.expectClose(t, closeProtocolError)
+			expectError(t, errs, errInvalidControlFrame)
+		})
+	}
+}
+
+func TestReadMessageLimitsSize(t *testing.T) {
+	t.Run("frame", func(t *testing.T) {
+		server, errs := echoServer(t)
+		client := dial(t, server)
+
+		// only the header is sent, the length alone is too large
+		header := []byte{0x80 | opBinary, 0x80 | 127}
+		header = binary.BigEndian.AppendUint64(header, maxMessageSize+1)
+		if _, err := client.conn.Write(append(header, testMask[:]...)); err != nil {
+			t.Fatal(err)
+		}
+		client.expectClose(t, closeMessageTooBig)
+		expectError(t, errs, errMessageTooLarge)
+	})
+
+	t.Run("fragments", func(t *testing.T) {
+		server, errs := echoServer(t)
+		client := dial(t, server)
+
+		half := bytes.Repeat([]byte("x"), maxMessageSize/2+1)
+		client.writeFrame(t, false, opBinary, half, true)
+		client.writeFrame(t, true, opContinuation, half, true)
+		client.expectClose(t, closeMessageTooBig)
+		expectError(t, errs, errMessageTooLarge)
+	})
+}
+
+func TestWriteFrameLengths(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrade(w, r)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for _, length := range []int{3, 200, 70000} {
+			if _, err := conn.Write(bytes.Repeat([]byte("o"), length)); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	client := dial(t, server)
+
+	for _, length := range []int{3, 200, 70000} {
+		if opcode, payload := client.readFrame(t); opcode != opBinary || len(payload) != length {
+			t.Errorf("got the opcode %d with %d bytes, want a binary frame of %d bytes", opcode, len(payload), length)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"strings"
+	"time"
 )
 
 // widget is a helper for rendering the widget of the terminal.
@@ -19,24 +20,139 @@ type widget struct {
 	// properties are hold the properties of the widget
 	properties *widgetProperties
 
-	// widgetLength is hold the length of the widget
-	widgetLength int
+	// rows are hold the widgets of the footer rows, nil if the widgets do not fit
+	rows [][]*commonWidget
+
+	// topRows are hold the widgets of the top bar rows, nil if there are none
+	topRows [][]*commonWidget
+
+	// position is the place of the widget bar, at the bottom, under the header or both
+	position WidgetPosition
+
+	// rowCount is hold the fixed number of the footer rows, zero wraps the widgets into as many rows as needed
+	rowCount int
+
+	// overflowPolicy decides what happens to the widgets which do not fit the footer
+	overflowPolicy WidgetOverflowPolicy
+
+	// overflow is hold the hidden and the truncated widgets of the last layout
+	overflow WidgetOverflowMsg
+
+	// history is hold the last values of the widgets by their keys
+	history map[string][]WidgetHistoryEntry
+
+	// historySize is hold the maximum number of values kept per widget
+	historySize int
+
+	// statusBar is rendered instead of the widgets when it is set
+	statusBar *StatusBar
+
+	// renderer replaces the built-in widget bar when it is set
+	renderer WidgetRenderer
+
+	// statusMessage is shown on the footer line next to the widgets, or on the status row if it is reserved
+	statusMessage string
+
+	// statusRow reserves a line above the widget bar for the status messages
+	statusRow bool
+
+	// statusRowEntries are hold the messages pushed to the status row which did not expire, the newest is the last
+	statusRowEntries []statusRowEntry
+
+	// description is the description of the active tab, it is shown on the footer line when there is no status message
+	description string
+
+	// clickHandlers are called when the widget by the key is clicked
+	clickHandlers map[string]WidgetClickHandler
+
+	// flashes are hold the keys of the widgets highlighted when their value changes, with the id of
+	// the running highlight, 0 is none
+	flashes map[string]int
+	flashID int
+
+	// flashExpiries are hold the ends of the highlights which are not scheduled yet
+	flashExpiries []widgetFlashExpiredMsg
+
+	// dynamicStarts are hold the dynamic widgets whose refreshing is not started yet
+	dynamicStarts []dynamicWidgetStart
+
+	// marquees are hold the keys of the widgets whose value scrolls when it doesn't fit, with the time
+	// the scrolling started at
+	marquees map[string]time.Time
+
+	// hitBoxes are hold the rendered horizontal ranges of the widgets for the mouse support
+	hitBoxes []widgetHitBox
+
+	// topHitBoxes are hold the rendered horizontal ranges of the widgets of the top bar
+	topHitBoxes []widgetHitBox
+
+	// sanitize removes the dangerous escape sequences from the values of the widgets when they are set
+	sanitize bool
+
+	// compact renders the widgets embedded into the bottom border of the frame, on a single line
+	compact bool
+
+	// hidden collapses the widget bar, only the bottom border of the frame is rendered
+	hidden bool
+
+	// focusedWidget is hold the index of the widget selected while the widget region has the keyboard focus, -1 is none
+	focusedWidget int
+
+	// clock is the source of time of the widget history
+	clock Clock
+
+	// activePage returns the key of the active page, only its widgets are shown besides the global ones
+	activePage func() string
+
+	// pageFooter returns the footer content of the active page, it replaces the widget bar when there is one
+	pageFooter func(width int) (string, bool)
 
 	updater *Updater
 }
 
-// newWidget returns a new Widget.
-func newWidget() *widget {
+// newWidget returns a new Widget which shares the viewport and the updater of its Skeleton.
+func newWidget(vp *viewport.Model, updater *Updater) *widget {
 	return &widget{
-		properties: defaultWidgetProperties(),
-		viewport:   newTerminalViewport(),
-		updater:    NewUpdater(),
+		properties:    defaultWidgetProperties(),
+		viewport:      vp,
+		updater:       updater,
+		history:       make(map[string][]WidgetHistoryEntry),
+		historySize:   defaultWidgetHistorySize,
+		clickHandlers: make(map[string]WidgetClickHandler),
+		flashes:       make(map[string]int),
+		marquees:      make(map[string]time.Time),
+		focusedWidget: -1,
+		clock:         SystemClock(),
 	}
 }
 
+// defaultWidgetHistorySize is the default number of values kept per widget.
+const defaultWidgetHistorySize = 20
+
 type commonWidget struct {
-	Key   string // Key is the name of the Value
-	Value string // Value is the content of the Value
+	Key      string          // Key is the name of the Value
+	Value    string          // Value is the content of the Value
+	Align    WidgetAlignment // Align is the group of the footer the Value is rendered in
+	Priority int             // Priority decides which widgets are dropped first when they do not fit
+
+	// kind formats the Value of the typed widgets, e.g. spinners and progress bars, it is nil for the plain ones
+	kind widgetKind
+
+	// page is the key of the page the widget is shown on, it is empty for the widgets shown on every page
+	page string
+
+	// top moves the widget to the top bar while the widget position is WidgetsBoth
+	top bool
+
+	// minWidth and maxWidth constrain the width of the Value, zero is unconstrained
+	minWidth int
+	maxWidth int
+}
+
+// WidgetHistoryEntry is a single recorded value of a widget.
+type WidgetHistoryEntry struct {
+	Value string    // Value is the content of the widget at that time
+	Time  time.Time // Time is the time the value was set
 }
 
 type widgetProperties struct {
@@ -44,30 +160,35 @@ type widgetProperties struct {
 	leftTabPadding  int
 	rightTabPadding int
 	widgetStyle     lipgloss.Style
+	glyphs          GlyphSet
+
+	// rendererGlyphs is hold the glyphs given to the custom renderer, they are not mirrored since its view is not flipped
+	rendererGlyphs GlyphSet
 }
 
 func defaultWidgetProperties() *widgetProperties {
 	borderColor := "39"
 	leftPadding := 2
 	rightPadding := 2
+	glyphs := UnicodeGlyphs()
 	return &widgetProperties{
 		borderColor:     borderColor,
 		leftTabPadding:  leftPadding,
 		rightTabPadding: rightPadding,
-		widgetStyle: func() lipgloss.Style {
-			b := lipgloss.RoundedBorder()
-			b.Right = "├"
-			b.Left = "┤"
-			return lipgloss.NewStyle().BorderStyle(b).
-				PaddingLeft(leftPadding).PaddingRight(rightPadding).
-				BorderForeground(lipgloss.Color("49"))
-		}(),
+		glyphs:          glyphs,
+		rendererGlyphs:  glyphs,
+		widgetStyle: lipgloss.NewStyle().BorderStyle(glyphs.widgetBorder()).
+			PaddingLeft(leftPadding).PaddingRight(rightPadding).
+			BorderForeground(lipgloss.Color("49")),
 	}
 }
 
 // SetBorderColor sets the border color of the Widget.
 func (w *widget) SetBorderColor(color string) *widget {
 	w.properties.borderColor = color
+	if w.statusBar != nil {
+		w.statusBar.borderColor = color
+	}
 	return w
 }
 
@@ -101,6 +222,40 @@ func (w *widget) SetRightPadding(padding int) *widget {
 	return w
 }
 
+// SetGlyphs sets the glyphs used to draw the Widget. When the widgets are flipped to the top, they are drawn
+// with the mirrored glyphs so that the borders look right once their lines are reversed.
+func (w *widget) SetGlyphs(glyphs GlyphSet, mirrored bool) *widget {
+	w.properties.rendererGlyphs = glyphs
+	if mirrored {
+		glyphs = mirroredGlyphs(glyphs)
+	}
+	w.properties.glyphs = glyphs
+	w.properties.widgetStyle = w.properties.widgetStyle.BorderStyle(glyphs.widgetBorder())
+	if w.statusBar != nil {
+		w.statusBar.glyphSeparator = glyphs.Separator
+	}
+	return w
+}
+
+// SetRenderer sets the renderer which replaces the built-in widget bar.
+func (w *widget) SetRenderer(renderer WidgetRenderer) *widget {
+	w.renderer = renderer
+	w.calculateWidgetLength()
+	return w
+}
+
+// SetStatusBar sets the status bar which is rendered instead of the widgets. Nil restores the widgets.
+func (w *widget) SetStatusBar(bar *StatusBar) *widget {
+	if bar != nil {
+		bar.borderColor = w.properties.borderColor
+		bar.glyphSeparator = w.properties.glyphs.Separator
+		bar.updater = w.updater
+	}
+	w.statusBar = bar
+	w.calculateWidgetLength()
+	return w
+}
+
 // GetWidget returns the Value by the given key.
 func (w *widget) GetWidget(key string) *commonWidget {
 	for _, widget := range w.widgets {
@@ -112,9 +267,50 @@ func (w *widget) GetWidget(key string) *commonWidget {
 	return nil
 }
 
+// GetWidgetHistory returns the recorded values of the widget by the given key, oldest first.
+func (w *widget) GetWidgetHistory(key string) []WidgetHistoryEntry {
+	history := w.history[key]
+	out := make([]WidgetHistoryEntry, len(history))
+	copy(out, history)
+	return out
+}
+
+// SetHistorySize sets the maximum number of values kept per widget.
+func (w *widget) SetHistorySize(size int) *widget {
+	if size < 0 {
+		size = 0
+	}
+	w.historySize = size
+	for key, history := range w.history {
+		if len(history) > size {
+			w.history[key] = history[len(history)-size:]
+		}
+	}
+	return w
+}
+
+// recordHistory records the given value of the widget.
+func (w *widget) recordHistory(key, value string) {
+	if w.historySize == 0 {
+		return
+	}
+
+	history := append(w.history[key], WidgetHistoryEntry{
+		Value: value,
+		Time:  w.clock.Now(),
+	})
+	if len(history) > w.historySize {
+		history = history[len(history)-w.historySize:]
+	}
+	w.history[key] = history
+}
+
 // DeleteAllWidgets deletes all the widgets.
 func (w *widget) DeleteAllWidgets() {
 	w.widgets = nil
+	w.history = make(map[string][]WidgetHistoryEntry)
+	w.clickHandlers = make(map[string]WidgetClickHandler)
+	w.flashes = make(map[string]int)
 	w.calculateWidgetLength()
 	w.updater.Update()
 }
@@ -125,18 +321,25 @@ func (w *widget) addNewWidget(key, value string) {
 		return
 	}
 
+	value = w.sanitizeValue(value)
 	w.widgets = append(w.widgets, &commonWidget{
 		Key:   key,
 		Value: value,
 	})
+	w.recordHistory(key, value)
 
 	w.calculateWidgetLength()
 	w.updater.Update()
 }
 
 func (w *widget) updateWidgetContent(key, value string) {
+	value = w.sanitizeValue(value)
 	x := w.GetWidget(key)
 	if x != nil {
+		if x.Value != value {
+			w.recordHistory(key, value)
+			w.flash(key)
+		}
 		x.Value = value
 	}
 
@@ -151,6 +354,10 @@ func (w *widget) deleteWidget(key string) {
 			break
 		}
 	}
+	delete(w.history, key)
+	delete(w.clickHandlers, key)
+	delete(w.flashes, key)
+	delete(w.marquees, key)
 
 	w.calculateWidgetLength()
 	w.updater.Update()
@@ -183,28 +390,30 @@ func (w *widget) Update(msg tea.Msg) (*widget, tea.Cmd) {
 	return w, tea.Batch(cmds...)
 }
 
-// calculateWidgetLength calculates the length of the widgets.
+// calculateWidgetLength lays out the widgets into the footer rows and reports whether they fit.
 func (w *widget) calculateWidgetLength() tea.Cmd {
-	var widgetLen int
-	for _, widget := range w.widgets {
-		widgetLen += len([]rune(widget.Value))
-		widgetLen += w.properties.leftTabPadding + w.properties.rightTabPadding
-		widgetLen += 2 // for the border between widgets
-	}
-
-	requiredLineCount := w.viewport.Width - (widgetLen + 2)
-
-	w.widgetLength = widgetLen
-
-	if requiredLineCount < 0 {
+	if w.renderer != nil {
+		// the custom renderer decides the size itself, it fits if it is not wider than the terminal
+		fits := lipgloss.Width(w.renderer.RenderWidgets(w.widgetState())) <= w.viewport.Width
+		w.rows, w.topRows = nil, nil
 		return func() tea.Msg {
-			return WidgetSizeMsg{NotEnoughToHandleWidgets: false}
+			return WidgetSizeMsg{NotEnoughToHandleWidgets: fits}
 		}
-	} else {
+	}
+	if w.statusBar != nil || w.compact || w.hidden {
+		// status bar, the compact and the hidden footer truncate themselves, they always fit
+		w.rows, w.topRows = nil, nil
 		return func() tea.Msg {
 			return WidgetSizeMsg{NotEnoughToHandleWidgets: true}
 		}
 	}
+
+	w.layoutBars()
+	// the footer of a page truncates itself, it fits while it replaces the widgets
+	fits := w.rows != nil || w.hasPageFooter()
+	return func() tea.Msg {
+		return WidgetSizeMsg{NotEnoughToHandleWidgets: fits}
+	}
 }
 
 func (w *widget) View() string {
@@ -212,33 +421,140 @@ func (w *widget) View() string {
 		return "setting up terminal..."
 	}
 
-	requiredLineCount := w.viewport.Width - (w.widgetLength + 2)
+	w.hitBoxes = nil
+
+	if w.hidden {
+		return w.frameLineView()
+	}
+
+	if view, ok := w.pageFooterView(); ok {
+		return view
+	}
+
+	if w.renderer != nil {
+		return w.renderer.RenderWidgets(w.widgetState())
+	}
 
-	if requiredLineCount < 0 {
+	if w.compact {
+		return w.compactFooterView()
+	}
+
+	if w.statusBar != nil {
+		return w.statusBarView()
+	}
+
+	if w.rows == nil {
 		return ""
 	}
 
-	line := strings.Repeat("─", requiredLineCount)
-	line = lipgloss.NewStyle().Foreground(lipgloss.Color(w.properties.borderColor)).Render(line)
+	views := make([]string, len(w.rows))
+	for i, row := range w.rows {
+		views[i] = w.rowView(row, i, i == len(w.rows)-1)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, views...)
+}
+
+// rowView renders a row of the footer. The last row is the bottom border of the frame and shows the
+// status message, the rows above it are joined to the side borders.
+func (w *widget) rowView(row []*commonWidget, index int, last bool) string {
+	borderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(w.properties.borderColor))
+	frame := w.properties.glyphs.Frame
 
-	var renderedWidgets = make([]string, len(w.widgets))
-	for i, wgt := range w.widgets {
-		renderedWidgets[i] = w.properties.widgetStyle.Render(wgt.Value)
+	requiredLineCount := w.viewport.Width - 2 // for the corners
+	for _, wgt := range row {
+		requiredLineCount -= w.widgetWidth(wgt)
 	}
 
-	leftCorner := lipgloss.JoinVertical(lipgloss.Top, "│", "╰")
-	rightCorner := lipgloss.JoinVertical(lipgloss.Top, "│", "╯")
-	leftCorner = lipgloss.NewStyle().Foreground(lipgloss.Color(w.properties.borderColor)).Render(leftCorner)
-	rightCorner = lipgloss.NewStyle().Foreground(lipgloss.Color(w.properties.borderColor)).Render(rightCorner)
+	var renderedWidgets = make([]string, len(row))
+	for i, wgt := range row {
+		focused := w.focusedWidget >= 0 && w.focusedWidget < len(w.widgets) && w.widgets[w.focusedWidget].Key == wgt.Key
+		renderedWidgets[i] = w.properties.widgetStyle.Render(w.highlight(wgt.Key, wgt.Value, focused))
+	}
+
+	// the line with the status message is rendered before the center group, the rest of it after
+	before, after := w.groupLines(requiredLineCount, row, renderedWidgets)
+	line := borderStyle.Render(strings.Repeat(frame.Bottom, before))
+	if last {
+		line = w.renderLine(before)
+	}
+	rest := borderStyle.Render(strings.Repeat(frame.Bottom, after))
 
+	// x starts after the left corner
+	x := 1
 	var bottom []string
-	bottom = append(bottom, line)
-	bottom = append(bottom, renderedWidgets...)
+	for i, wgt := range row {
+		if wgt.Align != WidgetAlignLeft && (i == 0 || row[i-1].Align == WidgetAlignLeft) {
+			bottom = append(bottom, line)
+			x += lipgloss.Width(line)
+		}
+		if wgt.Align == WidgetAlignRight && (i == 0 || row[i-1].Align != WidgetAlignRight) && after > 0 {
+			bottom = append(bottom, rest)
+			x += after
+		}
+		bottom = append(bottom, renderedWidgets[i])
+		width := lipgloss.Width(renderedWidgets[i])
+		w.hitBoxes = append(w.hitBoxes, widgetHitBox{key: wgt.Key, row: index, start: x, end: x + width})
+		x += width
+	}
+	if len(row) == 0 || row[len(row)-1].Align == WidgetAlignLeft {
+		bottom = append(bottom, line)
+	}
+	if after > 0 && (len(row) == 0 || row[len(row)-1].Align != WidgetAlignRight) {
+		bottom = append(bottom, rest)
+	}
+
+	leftCorner := lipgloss.JoinVertical(lipgloss.Top, frame.Left, frame.BottomLeft)
+	rightCorner := lipgloss.JoinVertical(lipgloss.Top, frame.Right, frame.BottomRight)
+	if !last {
+		leftCorner = lipgloss.JoinVertical(lipgloss.Top, frame.Left, frame.MiddleLeft, frame.Left)
+		rightCorner = lipgloss.JoinVertical(lipgloss.Top, frame.Right, frame.MiddleRight, frame.Right)
+	}
+	leftCorner = borderStyle.Render(leftCorner)
+	rightCorner = borderStyle.Render(rightCorner)
 
 	position := lipgloss.Center
-	if len(w.widgets) > 0 {
+	if len(row) > 0 {
 		position = lipgloss.Top
 	}
 
 	return lipgloss.JoinHorizontal(position, leftCorner, lipgloss.JoinHorizontal(lipgloss.Center, bottom...), rightCorner)
 }
+
+// frameLineView renders only the bottom border of the frame, it is used while the widget bar is hidden.
+func (w *widget) frameLineView() string {
+	frame := w.properties.glyphs.Frame
+	borderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(w.properties.borderColor))
+	return borderStyle.Render(frame.BottomLeft + strings.Repeat(frame.Bottom, max(w.viewport.Width-2, 0)) + frame.BottomRight)
+}
+
+// statusBarView renders the status bar on the bottom border line.
+func (w *widget) statusBarView() string {
+	borderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(w.properties.borderColor))
+	frame := w.properties.glyphs.Frame
+
+	bar := w.statusBar.Render(w.viewport.Width - 3) // for the corners and at least one line
+	line := w.renderLine(max(w.viewport.Width-2-lipgloss.Width(bar), 0))
+
+	leftCorner := borderStyle.Render(lipgloss.JoinVertical(lipgloss.Top, frame.Left, frame.BottomLeft))
+	rightCorner := borderStyle.Render(lipgloss.JoinVertical(lipgloss.Top, frame.Right, frame.BottomRight))
+
+	return lipgloss.JoinHorizontal(lipgloss.Bottom, leftCorner, line+bar, rightCorner)
+}
+
+// renderLine renders the footer line with the given width, the status message is embedded into it.
+func (w *widget) renderLine(width int) string {
+	borderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color(w.properties.borderColor))
+	frame := w.properties.glyphs.Frame
+
+	width = max(width, 0)
+
+	// the message needs the leading line and a space on both sides
+	text, style := w.footerMessage()
+	if text == "" || width < 4 {
+		return borderStyle.Render(strings.Repeat(frame.Bottom, width))
+	}
+
+	message := " " + style.Render(truncateText(text, width-3)) + " "
+	rest := width - 1 - lipgloss.Width(message)
+	return borderStyle.Render(frame.Bottom) + message + borderStyle.Render(strings.Repeat(frame.Bottom, rest))
+}
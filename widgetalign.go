@@ -0,0 +1,106 @@
+package skeleton
+
+import "github.com/charmbracelet/lipgloss"
+
+// WidgetAlignment decides which group of the footer a widget is rendered in.
+type WidgetAlignment int
+
+const (
+	// WidgetAlignRight renders the widget at the right end of the footer, this is the default.
+	WidgetAlignRight WidgetAlignment = iota
+	// WidgetAlignLeft renders the widget at the left end of the footer.
+	WidgetAlignLeft
+	// WidgetAlignCenter renders the widget in the middle of the footer.
+	WidgetAlignCenter
+)
+
+// order returns the position of the alignment group from the left of the footer.
+func (a WidgetAlignment) order() int {
+	switch a {
+	case WidgetAlignLeft:
+		return 0
+	case WidgetAlignCenter:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// AddWidgetLeft adds a new widget to the left group of the footer, e.g. for the app info.
+func (s *Skeleton) AddWidgetLeft(key string, value string) *Skeleton {
+	return s.addAlignedWidget(key, value, WidgetAlignLeft)
+}
+
+// AddWidgetCenter adds a new widget to the center group of the footer, e.g. for the status.
+func (s *Skeleton) AddWidgetCenter(key string, value string) *Skeleton {
+	return s.addAlignedWidget(key, value, WidgetAlignCenter)
+}
+
+// AddWidgetRight adds a new widget to the right group of the footer, e.g. for a clock. It is the same as AddWidget.
+func (s *Skeleton) AddWidgetRight(key string, value string) *Skeleton {
+	return s.addAlignedWidget(key, value, WidgetAlignRight)
+}
+
+// addAlignedWidget adds a new widget like AddWidget and moves it to the given group.
+func (s *Skeleton) addAlignedWidget(key string, value string, align WidgetAlignment) *Skeleton {
+	key, err := s.AddWidgetE(key, value)
+	if err != nil {
+		return s
+	}
+	return s.SetWidgetAlignment(key, align)
+}
+
+// SetWidgetAlignment moves the widget by the given key to the given group of the footer.
+// The Skeleton computes the spacing between the groups, the widgets keep their order inside a group.
+func (s *Skeleton) SetWidgetAlignment(key string, align WidgetAlignment) *Skeleton {
+	key = s.normalizeKey(key)
+	s.widget.setAlignment(key, align)
+	s.updater.Update()
+	return s
+}
+
+// GetWidgetAlignment returns the group of the footer the widget by the given key is rendered in.
+func (s *Skeleton) GetWidgetAlignment(key string) WidgetAlignment {
+	key = s.normalizeKey(key)
+	if wgt := s.widget.GetWidget(key); wgt != nil {
+		return wgt.Align
+	}
+	return WidgetAlignRight
+}
+
+// setAlignment sets the alignment of the widget and keeps the widgets sorted by their groups,
+// so the widgets are rendered and focused in the same order.
+func (w *widget) setAlignment(key string, align WidgetAlignment) {
+	wgt := w.GetWidget(key)
+	if wgt == nil {
+		return
+	}
+
+	wgt.Align = align
+	w.sortWidgets(func(a, b *commonWidget) int { return 0 })
+}
+
+// groupLines returns the widths of the line before and after the center group. The center group
+// is placed in the middle of the footer when there is enough space, the left and right groups
+// stay at the ends.
+func (w *widget) groupLines(total int, row []*commonWidget, rendered []string) (before int, after int) {
+	var leftWidth, centerWidth int
+	hasCenter := false
+	for i, wgt := range row {
+		switch wgt.Align {
+		case WidgetAlignLeft:
+			leftWidth += lipgloss.Width(rendered[i])
+		case WidgetAlignCenter:
+			centerWidth += lipgloss.Width(rendered[i])
+			hasCenter = true
+		}
+	}
+	if !hasCenter {
+		return total, 0
+	}
+
+	// the center group starts after the left corner, the left group and the line before it
+	before = (w.viewport.Width-centerWidth)/2 - 1 - leftWidth
+	before = min(max(before, 0), total)
+	return before, total - before
+}
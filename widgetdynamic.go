@@ -0,0 +1,94 @@
+package skeleton
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// dynamicWidget is a widget whose value is returned by a callback, the Skeleton calls it every interval.
+type dynamicWidget struct {
+	interval time.Duration
+	refresh  func() string
+	value    string
+}
+
+// format returns the last value returned by the callback.
+func (w *dynamicWidget) format(bool) string {
+	return w.value
+}
+
+// dynamicWidgetStart is hold a dynamic widget whose refreshing is started by the next update.
+type dynamicWidgetStart struct {
+	key    string
+	widget *dynamicWidget
+}
+
+// dynamicWidgetMsg is hold the value returned by the callback of the dynamic widget.
+type dynamicWidgetMsg struct {
+	key    string
+	widget *dynamicWidget
+	value  string
+}
+
+// AddDynamicWidget adds a widget whose value is returned by refresh, which is called right away and then
+// every interval. The callback runs as a command, so it may block, e.g. to read the CPU usage or the git branch.
+// The refreshing starts with the next update of the Skeleton, or when the program starts. It stops when the widget is deleted or its value is set by UpdateWidgetValue.
+func (s *Skeleton) AddDynamicWidget(key string, interval time.Duration, refresh func() string) *Skeleton {
+	if refresh == nil {
+		return s
+	}
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	kind := &dynamicWidget{interval: interval, refresh: refresh}
+	s.addKindWidget(key, kind)
+
+	// the widget key policy may have rejected the widget
+	key = s.normalizeKey(key)
+	if wgt := s.widget.GetWidget(key); wgt == nil || wgt.kind != kind {
+		return s
+	}
+	s.widget.dynamicStarts = append(s.widget.dynamicStarts, dynamicWidgetStart{key: key, widget: kind})
+	s.updater.Update()
+	return s
+}
+
+// dynamicWidgetStartCmd returns the commands which refresh the dynamic widgets added since the last update
+// for the first time. They are started by the update loop rather than a message, so they can not be dropped.
+func (s *Skeleton) dynamicWidgetStartCmd() tea.Cmd {
+	if len(s.widget.dynamicStarts) == 0 {
+		return nil
+	}
+	cmds := make([]tea.Cmd, len(s.widget.dynamicStarts))
+	for i, start := range s.widget.dynamicStarts {
+		cmds[i] = s.refreshDynamicWidget(start.key, start.widget, 0)
+	}
+	s.widget.dynamicStarts = nil
+	return tea.Batch(cmds...)
+}
+
+// refreshDynamicWidget returns a command which calls the callback of the dynamic widget once d elapses.
+func (s *Skeleton) refreshDynamicWidget(key string, kind *dynamicWidget, d time.Duration) tea.Cmd {
+	wait := s.clockTick(d, nil)
+	return func() tea.Msg {
+		if d > 0 {
+			wait()
+		}
+		return dynamicWidgetMsg{key: key, widget: kind, value: kind.refresh()}
+	}
+}
+
+// updateDynamicWidget shows the refreshed value of the dynamic widget and schedules the next refresh,
+// unless the widget was deleted or replaced in the meantime.
+func (s *Skeleton) updateDynamicWidget(msg dynamicWidgetMsg) tea.Cmd {
+	wgt := s.widget.GetWidget(msg.key)
+	if wgt == nil || wgt.kind != msg.widget {
+		return nil
+	}
+
+	msg.widget.value = msg.value
+	s.widget.updateWidgetContent(msg.key, msg.value)
+	return s.refreshDynamicWidget(msg.key, msg.widget, msg.widget.interval)
+}
@@ -0,0 +1,23 @@
+package skeleton
+
+import (
+	"sync/atomic"
+	"testing"
+)
+
+func TestDynamicWidgetRefreshesAfterFullSetup(t *testing.T) {
+	s, _ := newFullSkeleton(t)
+	var refreshes atomic.Int32
+	s.AddDynamicWidget("procs", 0, func() string {
+		refreshes.Add(1)
+		return "Procs: 42"
+	})
+	p := runTestProgram(t, s)
+
+	eventually(t, p, func() bool {
+		return widgetValue(s, "procs") == "Procs: 42"
+	})
+	if got := refreshes.Load(); got != 1 {
+		t.Errorf("refreshed %d times before the interval elapsed, want 1", got)
+	}
+}
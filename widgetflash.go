@@ -0,0 +1,84 @@
+package skeleton
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// widgetFlashDuration is how long a widget is highlighted after its value changes.
+const widgetFlashDuration = 500 * time.Millisecond
+
+// widgetFlashExpiredMsg ends the highlight of the widget by the key, unless a newer change restarted it.
+type widgetFlashExpiredMsg struct {
+	key string
+	id  int
+}
+
+// SetWidgetFlash highlights the widget by the given key for a moment every time its value changes,
+// so important updates in the footer are noticeable. The ticks of the spinner and the clock widgets do not flash.
+func (s *Skeleton) SetWidgetFlash(key string, flash bool) *Skeleton {
+	key = s.normalizeKey(key)
+	if flash {
+		if _, ok := s.widget.flashes[key]; !ok {
+			s.widget.flashes[key] = 0
+		}
+	} else {
+		delete(s.widget.flashes, key)
+	}
+	s.updater.Update()
+	return s
+}
+
+// GetWidgetFlash returns the widget by the given key is highlighted when its value changes or not.
+func (s *Skeleton) GetWidgetFlash(key string) bool {
+	key = s.normalizeKey(key)
+	_, ok := s.widget.flashes[key]
+	return ok
+}
+
+// flash highlights the widget by the given key if it flashes. The end of the highlight is scheduled by
+// the next update of the Skeleton, see flashExpiryCmd.
+func (w *widget) flash(key string) {
+	if _, ok := w.flashes[key]; !ok {
+		return
+	}
+
+	w.flashID++
+	w.flashes[key] = w.flashID
+	w.flashExpiries = append(w.flashExpiries, widgetFlashExpiredMsg{key: key, id: w.flashID})
+	w.updater.Update()
+}
+
+// flashExpiryCmd returns the commands which end the highlights started since the last update. The ends
+// are commands rather than updates, so they can not be dropped and leave a widget highlighted.
+func (s *Skeleton) flashExpiryCmd() tea.Cmd {
+	if len(s.widget.flashExpiries) == 0 {
+		return nil
+	}
+	cmds := make([]tea.Cmd, len(s.widget.flashExpiries))
+	for i, expiry := range s.widget.flashExpiries {
+		cmds[i] = s.clockTick(widgetFlashDuration, expiry)
+	}
+	s.widget.flashExpiries = nil
+	return tea.Batch(cmds...)
+}
+
+// endFlash ends the highlight of the widget, unless it was restarted by a newer change.
+func (w *widget) endFlash(msg widgetFlashExpiredMsg) {
+	if id, ok := w.flashes[msg.key]; ok && id == msg.id {
+		w.flashes[msg.key] = 0
+	}
+}
+
+// highlight renders the value inverted if the widget by the given key is focused or flashing.
+func (w *widget) highlight(key, value string, focused bool) string {
+	switch {
+	case w.flashes[key] != 0:
+		return lipgloss.NewStyle().Reverse(true).Bold(true).Render(value)
+	case focused:
+		return lipgloss.NewStyle().Reverse(true).Render(value)
+	}
+	return value
+}
@@ -0,0 +1,68 @@
+package skeleton
+
+import (
+	"errors"
+	"fmt"
+)
+
+// WidgetKeyPolicy decides what AddWidget does when a widget with the same key already exists.
+type WidgetKeyPolicy int
+
+const (
+	// WidgetKeyReject keeps the existing widget and rejects the new one, this is the default.
+	WidgetKeyReject WidgetKeyPolicy = iota
+	// WidgetKeyReplace replaces the value of the existing widget.
+	WidgetKeyReplace
+	// WidgetKeySuffix adds the new widget with a numeric suffix, e.g. "cpu-2".
+	WidgetKeySuffix
+)
+
+// ErrWidgetKeyExists is returned by AddWidgetE when the key is taken and the policy is WidgetKeyReject.
+var ErrWidgetKeyExists = errors.New("skeleton: widget key already exists")
+
+// SetWidgetKeyPolicy sets the policy for adding widgets with a key which already exists.
+func (s *Skeleton) SetWidgetKeyPolicy(policy WidgetKeyPolicy) *Skeleton {
+	s.properties.widgetKeyPolicy = policy
+	return s
+}
+
+// GetWidgetKeyPolicy returns the policy for adding widgets with a key which already exists.
+func (s *Skeleton) GetWidgetKeyPolicy() WidgetKeyPolicy {
+	return s.properties.widgetKeyPolicy
+}
+
+// AddWidgetE adds a new widget like AddWidget, following the widget key policy. It returns the key
+// the widget is stored by, which differs from the given key with WidgetKeySuffix or key normalization,
+// ErrWidgetKeyExists if the widget is rejected and ErrInvalidKey if the key is invalid.
+func (s *Skeleton) AddWidgetE(key string, value string) (string, error) {
+	key = s.normalizeKey(key)
+	if !s.validKey(key) {
+		return key, fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+
+	if s.widget.GetWidget(key) != nil {
+		switch s.properties.widgetKeyPolicy {
+		case WidgetKeyReplace:
+			s.widget.updateWidgetContent(key, value)
+			return key, nil
+		case WidgetKeySuffix:
+			key = s.widget.freeKey(key)
+		default:
+			return key, fmt.Errorf("%w: %q", ErrWidgetKeyExists, key)
+		}
+	}
+
+	s.widget.addNewWidget(key, value)
+	s.updater.Update()
+	return key, nil
+}
+
+// freeKey returns the given key with the lowest numeric suffix which is not taken.
+func (w *widget) freeKey(key string) string {
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s-%d", key, i)
+		if w.GetWidget(candidate) == nil {
+			return candidate
+		}
+	}
+}
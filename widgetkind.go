@@ -0,0 +1,285 @@
+package skeleton
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// progressWidgetWidth is the width of the bar of the progress widgets, without the percentage.
+const progressWidgetWidth = 10
+
+// The default thresholds of the gauge widgets, in percent.
+const (
+	defaultGaugeWarning  = 60
+	defaultGaugeCritical = 80
+)
+
+// widgetKind formats the value of a typed widget, e.g. a spinner or a progress bar.
+type widgetKind interface {
+	format(ascii bool) string
+}
+
+// spinnerWidget is an animated spinner followed by a label, the Skeleton advances its frames.
+type spinnerWidget struct {
+	label string
+	frame int
+}
+
+// progressWidget is a progress bar followed by its percentage.
+type progressWidget struct {
+	percent float64
+}
+
+// gaugeWidget is a label followed by a level glyph and the percentage, colored by the thresholds.
+type gaugeWidget struct {
+	label    string
+	value    float64
+	max      float64
+	warning  float64
+	critical float64
+}
+
+// clockWidget is the current time formatted by the layout, the Skeleton keeps it current.
+type clockWidget struct {
+	layout string
+	clock  Clock
+}
+
+// widgetTickMsg advances the frames of the spinner widgets and refreshes the clock widgets.
+type widgetTickMsg struct{}
+
+// spinnerFrames returns the frames of the spinner widgets.
+func spinnerFrames(ascii bool) spinner.Spinner {
+	if ascii {
+		return spinner.Line
+	}
+	return spinner.MiniDot
+}
+
+// format returns the current frame and the label.
+func (w *spinnerWidget) format(ascii bool) string {
+	frames := spinnerFrames(ascii).Frames
+	frame := frames[w.frame%len(frames)]
+	if w.label == "" {
+		return frame
+	}
+	return frame + " " + w.label
+}
+
+// format returns the current time.
+func (w *clockWidget) format(bool) string {
+	return w.clock.Now().Format(w.layout)
+}
+
+// format returns the bar and the percentage, the bar has eighth-cell precision with the unicode glyphs.
+func (w *progressWidget) format(ascii bool) string {
+	percent := min(max(w.percent, 0), 100)
+	filled := percent / 100 * progressWidgetWidth
+
+	var bar string
+	if ascii {
+		full := int(math.Round(filled))
+		bar = "[" + strings.Repeat("#", full) + strings.Repeat("-", progressWidgetWidth-full) + "]"
+	} else {
+		partials := []string{"", "▏", "▎", "▍", "▌", "▋", "▊", "▉"}
+		full := int(filled)
+		bar = strings.Repeat("█", full)
+		if partial := int((filled - float64(full)) * 8); full < progressWidgetWidth {
+			bar += partials[partial]
+			bar += strings.Repeat(" ", progressWidgetWidth-full-lipgloss.Width(partials[partial]))
+		}
+		bar = lipgloss.NewStyle().Background(lipgloss.Color("237")).Render(bar)
+	}
+	return fmt.Sprintf("%s %3.0f%%", bar, percent)
+}
+
+// format returns the label, the level glyph and the percentage, colored by the thresholds.
+func (w *gaugeWidget) format(ascii bool) string {
+	percent := 0.0
+	if w.max > 0 {
+		percent = min(max(w.value/w.max*100, 0), 100)
+	}
+
+	color := "39" // blue for normal
+	switch {
+	case percent >= w.critical:
+		color = "196" // red for critical
+	case percent >= w.warning:
+		color = "208" // orange for warning
+	}
+
+	value := fmt.Sprintf("%.0f%%", percent)
+	if !ascii {
+		levels := []string{"▁", "▂", "▃", "▄", "▅", "▆", "▇", "█"}
+		value = levels[min(int(percent/100*float64(len(levels))), len(levels)-1)] + " " + value
+	}
+	value = lipgloss.NewStyle().Foreground(lipgloss.Color(color)).Render(value)
+	if w.label == "" {
+		return value
+	}
+	return w.label + " " + value
+}
+
+// AddSpinnerWidget adds a widget with an animated spinner followed by the given label,
+// the Skeleton animates it from its next update until the widget is deleted.
+func (s *Skeleton) AddSpinnerWidget(key string, label string) *Skeleton {
+	return s.addKindWidget(key, &spinnerWidget{label: label})
+}
+
+// AddClockWidget adds a widget with the current time formatted by the given layout, e.g. time.Kitchen.
+// An empty layout is "15:04:05". The Skeleton ticks it from its next update, so there is no goroutine to
+// run and stop.
+func (s *Skeleton) AddClockWidget(key string, layout string) *Skeleton {
+	if layout == "" {
+		layout = time.TimeOnly
+	}
+	return s.addKindWidget(key, &clockWidget{layout: layout, clock: s.clock})
+}
+
+// AddProgressWidget adds a widget with a progress bar of the given percentage, from 0 to 100.
+func (s *Skeleton) AddProgressWidget(key string, percent float64) *Skeleton {
+	return s.addKindWidget(key, &progressWidget{percent: percent})
+}
+
+// AddGaugeWidget adds a widget with the given label and the level of the value within max. The level is
+// colored orange from 60% and red from 80%, see SetGaugeThresholds.
+func (s *Skeleton) AddGaugeWidget(key string, label string, value float64, max float64) *Skeleton {
+	return s.addKindWidget(key, &gaugeWidget{
+		label:    label,
+		value:    value,
+		max:      max,
+		warning:  defaultGaugeWarning,
+		critical: defaultGaugeCritical,
+	})
+}
+
+// SetWidgetProgress sets the percentage of the progress widget by the given key.
+func (s *Skeleton) SetWidgetProgress(key string, percent float64) *Skeleton {
+	return s.updateKindWidget(key, func(kind widgetKind) {
+		if progress, ok := kind.(*progressWidget); ok {
+			progress.percent = percent
+		}
+	})
+}
+
+// SetWidgetGauge sets the value of the gauge widget by the given key.
+func (s *Skeleton) SetWidgetGauge(key string, value float64) *Skeleton {
+	return s.updateKindWidget(key, func(kind widgetKind) {
+		if gauge, ok := kind.(*gaugeWidget); ok {
+			gauge.value = value
+		}
+	})
+}
+
+// SetGaugeThresholds sets the percentages the gauge widget by the given key is colored orange and red from.
+func (s *Skeleton) SetGaugeThresholds(key string, warning float64, critical float64) *Skeleton {
+	return s.updateKindWidget(key, func(kind widgetKind) {
+		if gauge, ok := kind.(*gaugeWidget); ok {
+			gauge.warning, gauge.critical = warning, critical
+		}
+	})
+}
+
+// SetWidgetLabel sets the label of the spinner or the gauge widget by the given key.
+func (s *Skeleton) SetWidgetLabel(key string, label string) *Skeleton {
+	return s.updateKindWidget(key, func(kind widgetKind) {
+		switch kind := kind.(type) {
+		case *spinnerWidget:
+			kind.label = label
+		case *gaugeWidget:
+			kind.label = label
+		}
+	})
+}
+
+// addKindWidget adds a typed widget with its formatted value.
+func (s *Skeleton) addKindWidget(key string, kind widgetKind) *Skeleton {
+	key, err := s.AddWidgetE(key, kind.format(s.widget.isASCII()))
+	if err != nil {
+		return s
+	}
+	s.widget.GetWidget(key).kind = kind
+	return s
+}
+
+// updateKindWidget changes the typed widget by the given key and formats its value again.
+func (s *Skeleton) updateKindWidget(key string, update func(kind widgetKind)) *Skeleton {
+	key = s.normalizeKey(key)
+	wgt := s.widget.GetWidget(key)
+	if wgt == nil || wgt.kind == nil {
+		return s
+	}
+	update(wgt.kind)
+	s.widget.updateWidgetContent(key, wgt.kind.format(s.widget.isASCII()))
+	s.updater.Update()
+	return s
+}
+
+// isASCII returns true if the widgets are drawn with the ASCII glyphs only.
+func (w *widget) isASCII() bool {
+	return w.properties.glyphs.Requires == GlyphSupportASCII
+}
+
+// tickWidgets schedules the next tick of the spinner, the clock and the marquee widgets, while there are any.
+// The spinners tick by their frame rate, the marquees by their step and the clocks on the start of every second.
+func (s *Skeleton) tickWidgets() tea.Cmd {
+	if s.widgetsTicking {
+		return nil
+	}
+
+	var spinners, clocks bool
+	for _, wgt := range s.widget.widgets {
+		switch wgt.kind.(type) {
+		case *spinnerWidget:
+			spinners = true
+		case *clockWidget:
+			clocks = true
+		}
+	}
+
+	switch {
+	case spinners:
+		s.widgetsTicking = true
+		return s.clockTick(spinnerFrames(s.widget.isASCII()).FPS, widgetTickMsg{})
+	case s.widget.hasMarquees():
+		s.widgetsTicking = true
+		return s.clockTick(marqueeStep, widgetTickMsg{})
+	case clocks:
+		// tick at the start of the next second, like tea.Every
+		s.widgetsTicking = true
+		now := s.clock.Now()
+		return s.clockTick(now.Truncate(time.Second).Add(time.Second).Sub(now), widgetTickMsg{})
+	}
+	return nil
+}
+
+// advanceWidgets shows the next frame of the spinner widgets, the current time of the clock widgets and
+// scrolls the marquee widgets. The ticks are not recorded in the widget history.
+func (s *Skeleton) advanceWidgets() tea.Cmd {
+	s.widgetsTicking = false
+	// the marquee widgets are cut again at their new position
+	resized := s.widget.hasMarquees()
+	for _, wgt := range s.widget.widgets {
+		switch kind := wgt.kind.(type) {
+		case *spinnerWidget:
+			kind.frame++
+		case *clockWidget:
+		default:
+			continue
+		}
+		value := s.widget.sanitizeValue(wgt.kind.format(s.widget.isASCII()))
+		resized = resized || ansi.StringWidth(value) != ansi.StringWidth(wgt.Value)
+		wgt.Value = value
+	}
+	if resized {
+		s.widget.calculateWidgetLength()
+	}
+	return s.tickWidgets()
+}
@@ -0,0 +1,46 @@
+package skeleton
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// newFullSkeleton returns a Skeleton on a simulated clock whose updater buffer was filled by the setup.
+func newFullSkeleton(t *testing.T) (*Skeleton, *SimulatedClock) {
+	t.Helper()
+
+	clock := NewSimulatedClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+	s := NewSkeleton().SetClock(clock)
+	for i := range 150 {
+		s.AddPage(fmt.Sprintf("p%d", i), fmt.Sprintf("Page %d", i), newTestPage())
+	}
+	if got := len(s.updater.rcv); got != cap(s.updater.rcv) {
+		t.Fatalf("the setup filled %d of %d updates", got, cap(s.updater.rcv))
+	}
+	return s, clock
+}
+
+func TestClockWidgetTicksAfterFullSetup(t *testing.T) {
+	s, clock := newFullSkeleton(t)
+	s.AddClockWidget("time", time.TimeOnly)
+	p := runTestProgram(t, s)
+
+	eventually(t, p, func() bool {
+		clock.Advance(time.Second)
+		return widgetValue(s, "time") != "12:00:00"
+	})
+}
+
+func TestSpinnerWidgetTicksAfterFullSetup(t *testing.T) {
+	s, clock := newFullSkeleton(t)
+	s.AddSpinnerWidget("busy", "loading")
+	p := runTestProgram(t, s)
+
+	var first string
+	onLoop(p, func() { first = widgetValue(s, "busy") })
+	eventually(t, p, func() bool {
+		clock.Advance(time.Second)
+		return widgetValue(s, "busy") != first
+	})
+}
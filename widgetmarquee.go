@@ -0,0 +1,61 @@
+package skeleton
+
+import (
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// marqueeStep is how long a scrolling widget value stays before it moves by one cell.
+const marqueeStep = 250 * time.Millisecond
+
+// marqueeGap separates the end of a scrolling widget value from its start.
+const marqueeGap = "   "
+
+// SetWidgetMarquee scrolls the value of the widget by the given key horizontally when it is wider than
+// the space it gets, instead of truncating it, e.g. for the "now playing" or long status widgets.
+// The Skeleton scrolls it on its tick, which its next update starts, so there is no goroutine to run and stop.
+// The widget may be added later, it scrolls once it exists.
+func (s *Skeleton) SetWidgetMarquee(key string, marquee bool) *Skeleton {
+	key = s.normalizeKey(key)
+	if marquee {
+		if _, ok := s.widget.marquees[key]; !ok {
+			s.widget.marquees[key] = s.clock.Now()
+		}
+	} else {
+		delete(s.widget.marquees, key)
+	}
+	s.widget.calculateWidgetLength()
+	s.updater.Update()
+	return s
+}
+
+// GetWidgetMarquee returns the value of the widget by the given key scrolls when it doesn't fit or not.
+func (s *Skeleton) GetWidgetMarquee(key string) bool {
+	key = s.normalizeKey(key)
+	_, ok := s.widget.marquees[key]
+	return ok
+}
+
+// hasMarquees returns true if there are widgets whose value scrolls when it doesn't fit.
+func (w *widget) hasMarquees() bool {
+	for _, wgt := range w.widgets {
+		if _, ok := w.marquees[wgt.Key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// marqueeWindow returns the part of the value of the widget by the given key which is shown in the given
+// width at the moment, false if the widget doesn't scroll.
+func (w *widget) marqueeWindow(key, value string, width int) (string, bool) {
+	start, ok := w.marquees[key]
+	if !ok {
+		return "", false
+	}
+
+	loop := value + marqueeGap
+	offset := int(w.clock.Now().Sub(start)/marqueeStep) % ansi.StringWidth(loop)
+	return ansi.Cut(loop+loop, offset, offset+width), true
+}
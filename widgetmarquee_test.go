@@ -0,0 +1,31 @@
+package skeleton
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMarqueeScrollsWidgetAddedLater(t *testing.T) {
+	clock := NewSimulatedClock(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	s := NewSkeleton().SetClock(clock)
+	s.AddPage("page", "Page", newTestPage())
+	s.SetWidgetMarquee("song", true)
+	p := runTestProgram(t, s)
+
+	// the widget is added after the program started and the first ticks found no marquee
+	clock.Advance(time.Second)
+	onLoop(p, func() {
+		s.AddWidget("song", strings.Repeat("now playing ", 4)).SetWidgetWidth("song", 10)
+	})
+	p.Send(tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	var first string
+	onLoop(p, func() { first = s.widget.View() })
+	eventually(t, p, func() bool {
+		clock.Advance(marqueeStep)
+		return s.widget.View() != first
+	})
+}
@@ -0,0 +1,90 @@
+package skeleton
+
+import "slices"
+
+// SetWidgetOrder reorders the widgets by the given keys. The widgets which are not listed keep their order
+// after the listed ones, and the widgets stay in their alignment groups, see SetWidgetAlignment.
+func (s *Skeleton) SetWidgetOrder(keys []string) *Skeleton {
+	position := make(map[string]int, len(keys))
+	for i, key := range keys {
+		position[s.normalizeKey(key)] = i
+	}
+	s.widget.sortWidgets(func(a, b *commonWidget) int {
+		pa, okA := position[a.Key]
+		pb, okB := position[b.Key]
+		switch {
+		case okA && okB:
+			return pa - pb
+		case okA:
+			return -1
+		case okB:
+			return 1
+		}
+		return 0
+	})
+	s.updater.Update()
+	return s
+}
+
+// GetWidgetOrder returns the keys of the widgets in the order they are rendered.
+func (s *Skeleton) GetWidgetOrder() []string {
+	keys := make([]string, len(s.widget.widgets))
+	for i, wgt := range s.widget.widgets {
+		keys[i] = wgt.Key
+	}
+	return keys
+}
+
+// SetWidgetPriority sets the priority of the widget by the given key, the default is zero. When the widgets
+// do not fit the footer rows (see SetWidgetRows), the widgets with the lowest priority are dropped first
+// until the rest fit.
+func (s *Skeleton) SetWidgetPriority(key string, priority int) *Skeleton {
+	key = s.normalizeKey(key)
+	if wgt := s.widget.GetWidget(key); wgt != nil {
+		wgt.Priority = priority
+		s.widget.calculateWidgetLength()
+	}
+	s.updater.Update()
+	return s
+}
+
+// GetWidgetPriority returns the priority of the widget by the given key.
+func (s *Skeleton) GetWidgetPriority(key string) int {
+	key = s.normalizeKey(key)
+	if wgt := s.widget.GetWidget(key); wgt != nil {
+		return wgt.Priority
+	}
+	return 0
+}
+
+// sortWidgets sorts the widgets by the given function inside their alignment groups, the focused widget keeps its focus.
+func (w *widget) sortWidgets(cmp func(a, b *commonWidget) int) {
+	var focused *commonWidget
+	if w.focusedWidget >= 0 && w.focusedWidget < len(w.widgets) {
+		focused = w.widgets[w.focusedWidget]
+	}
+
+	slices.SortStableFunc(w.widgets, func(a, b *commonWidget) int {
+		if order := a.Align.order() - b.Align.order(); order != 0 {
+			return order
+		}
+		return cmp(a, b)
+	})
+
+	if focused != nil {
+		w.focusedWidget = slices.Index(w.widgets, focused)
+	}
+	w.calculateWidgetLength()
+	w.updater.Update()
+}
+
+// lowestPriority returns the index of the widget which is dropped first, the last one of the lowest priority.
+func lowestPriority(widgets []*commonWidget) int {
+	lowest := 0
+	for i, wgt := range widgets {
+		if wgt.Priority <= widgets[lowest].Priority {
+			lowest = i
+		}
+	}
+	return lowest
+}
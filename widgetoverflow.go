@@ -0,0 +1,104 @@
+package skeleton
+
+import (
+	"slices"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// minWidgetValueWidth is the narrowest a widget value is truncated to, with its ellipsis.
+const minWidgetValueWidth = 3
+
+// WidgetOverflowPolicy decides what happens to the widgets which do not fit the footer.
+type WidgetOverflowPolicy int
+
+const (
+	// WidgetOverflowDrop hides the widgets with the lowest priority until the rest fit, this is the default.
+	WidgetOverflowDrop WidgetOverflowPolicy = iota
+	// WidgetOverflowTruncate shortens the widest values with an ellipsis first, and drops the widgets
+	// with the lowest priority only when the values can not be shortened any more.
+	WidgetOverflowTruncate
+)
+
+// WidgetOverflowMsg is sent to all pages when the widgets which do not fit the footer change.
+type WidgetOverflowMsg struct {
+	// Dropped are the keys of the hidden widgets
+	Dropped []string
+
+	// Truncated are the keys of the widgets whose values are shortened
+	Truncated []string
+}
+
+// SetWidgetOverflow sets what happens to the widgets which do not fit the footer rows, see WidgetOverflowPolicy
+// and SetWidgetRows. A widget wider than the whole footer is always truncated.
+func (s *Skeleton) SetWidgetOverflow(policy WidgetOverflowPolicy) *Skeleton {
+	s.widget.overflowPolicy = policy
+	s.widget.calculateWidgetLength()
+	s.updater.Update()
+	return s
+}
+
+// GetWidgetOverflow returns what happens to the widgets which do not fit the footer.
+func (s *Skeleton) GetWidgetOverflow() WidgetOverflowPolicy {
+	return s.widget.overflowPolicy
+}
+
+// GetWidgetOverflowState returns the widgets which are hidden or truncated in the last layout of the footer.
+func (s *Skeleton) GetWidgetOverflowState() WidgetOverflowMsg {
+	return s.widget.overflow
+}
+
+// truncateWidget returns the given widget, or a copy of it whose value is truncated to the given width.
+// The value of a marquee widget is not truncated but cut at its current scroll position.
+func (w *widget) truncateWidget(wgt *commonWidget, width int) *commonWidget {
+	if ansi.StringWidth(wgt.Value) <= width {
+		return wgt
+	}
+	truncated := *wgt
+	truncated.Value = truncateText(wgt.Value, max(width, 1))
+	if original := w.GetWidget(wgt.Key); original != nil {
+		if window, ok := w.marqueeWindow(wgt.Key, original.Value, max(width, 1)); ok {
+			truncated.Value = window
+		}
+	}
+	return &truncated
+}
+
+// shrinkWidgets truncates the widest values by one cell at a time until the widgets fit the rows,
+// or none of them can be shortened any more.
+func (w *widget) shrinkWidgets(widgets []*commonWidget) []*commonWidget {
+	widgets = slices.Clone(widgets)
+	for w.splitRows(widgets) == nil {
+		widest := -1
+		for i, wgt := range widgets {
+			width := ansi.StringWidth(wgt.Value)
+			if width > minWidgetValueWidth && (widest < 0 || width > ansi.StringWidth(widgets[widest].Value)) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			break
+		}
+		original := w.GetWidget(widgets[widest].Key)
+		widgets[widest] = w.truncateWidget(original, ansi.StringWidth(widgets[widest].Value)-1)
+	}
+	return widgets
+}
+
+// reportOverflow records the hidden and the truncated widgets of the layout, and sends WidgetOverflowMsg
+// to the pages when they changed.
+func (w *widget) reportOverflow(shown []*commonWidget, dropped []string) {
+	var truncated []string
+	for _, wgt := range shown {
+		// the values padded to their minimum width are copies too, but they are not truncated
+		if original := w.GetWidget(wgt.Key); original != nil && ansi.StringWidth(wgt.Value) < ansi.StringWidth(original.Value) {
+			truncated = append(truncated, wgt.Key)
+		}
+	}
+
+	if slices.Equal(dropped, w.overflow.Dropped) && slices.Equal(truncated, w.overflow.Truncated) {
+		return
+	}
+	w.overflow = WidgetOverflowMsg{Dropped: dropped, Truncated: truncated}
+	w.updater.UpdateWithMsg(broadcastMsg{msg: w.overflow})
+}
@@ -0,0 +1,44 @@
+package skeleton
+
+import (
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// overflowPage records the last WidgetOverflowMsg it receives.
+type overflowPage struct {
+	last *WidgetOverflowMsg
+}
+
+func (p *overflowPage) Init() tea.Cmd { return nil }
+
+func (p *overflowPage) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if msg, ok := msg.(WidgetOverflowMsg); ok {
+		p.last = &msg
+	}
+	return p, nil
+}
+
+func (p *overflowPage) View() string { return "overflow page" }
+
+func TestWidgetOverflowTruncateWithDefaultRows(t *testing.T) {
+	page := &overflowPage{}
+	s := NewSkeleton()
+	s.AddPage("page", "Page", page)
+	s.SetWidgetOverflow(WidgetOverflowTruncate)
+	addNumberedWidgets(s, 12)
+	updateSync(s, tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	state := s.GetWidgetOverflowState()
+	if len(state.Truncated) == 0 {
+		t.Fatalf("no widget is truncated: %+v", state)
+	}
+	if page.last == nil {
+		t.Fatal("the page did not receive WidgetOverflowMsg")
+	}
+	if !slices.Equal(page.last.Truncated, state.Truncated) || !slices.Equal(page.last.Dropped, state.Dropped) {
+		t.Errorf("the page received %+v, want %+v", *page.last, state)
+	}
+}
@@ -0,0 +1,90 @@
+package skeleton
+
+// pageWidgetKey returns the key a widget of the given page is stored by, e.g. "news/count".
+func pageWidgetKey(page, key string) string {
+	return page + "/" + key
+}
+
+// AddPageWidget adds a widget which belongs to the page by the given key. It is shown only while the
+// page is active and it is deleted with the page. The widget is stored by the key "page/key", e.g.
+// "news/count", so the pages can use the same widget keys; the other widget methods accept it as well.
+func (s *Skeleton) AddPageWidget(page string, key string, value string) *Skeleton {
+	page = s.normalizeKey(page)
+	if !s.hasPage(page) {
+		return s
+	}
+
+	key, err := s.AddWidgetE(pageWidgetKey(page, s.normalizeKey(key)), value)
+	if err != nil {
+		return s
+	}
+	s.widget.GetWidget(key).page = page
+	s.widget.calculateWidgetLength()
+	return s
+}
+
+// UpdatePageWidgetValue updates the value of the widget by the given key of the page by the given key.
+// Adds the widget if it doesn't exist.
+func (s *Skeleton) UpdatePageWidgetValue(page string, key string, value string) *Skeleton {
+	page, key = s.normalizeKey(page), s.normalizeKey(key)
+	if s.widget.GetWidget(pageWidgetKey(page, key)) == nil {
+		return s.AddPageWidget(page, key, value)
+	}
+	return s.UpdateWidgetValue(pageWidgetKey(page, key), value)
+}
+
+// DeletePageWidget deletes the widget by the given key of the page by the given key.
+func (s *Skeleton) DeletePageWidget(page string, key string) *Skeleton {
+	return s.DeleteWidget(pageWidgetKey(s.normalizeKey(page), s.normalizeKey(key)))
+}
+
+// GetWidgetPage returns the key of the page which the widget by the given key belongs to, empty for
+// the widgets shown on every page.
+func (s *Skeleton) GetWidgetPage(key string) string {
+	key = s.normalizeKey(key)
+	if wgt := s.widget.GetWidget(key); wgt != nil {
+		return wgt.page
+	}
+	return ""
+}
+
+// deletePageWidgets deletes the widgets of the page by the given key.
+func (s *Skeleton) deletePageWidgets(page string) {
+	var keys []string
+	for _, wgt := range s.widget.widgets {
+		if wgt.page == page {
+			keys = append(keys, wgt.Key)
+		}
+	}
+	for _, key := range keys {
+		s.widget.deleteWidget(key)
+	}
+}
+
+// activeWidgetPage returns the key of the active page, the widgets of the other pages are hidden.
+func (s *Skeleton) activeWidgetPage() string {
+	if s.currentTab < 0 || s.currentTab >= len(s.header.headers) {
+		return ""
+	}
+	return s.header.headers[s.currentTab].key
+}
+
+// isShown returns true if the widget is global or belongs to the active page. The key hint widgets
+// are hidden while the active page has no hints.
+func (w *widget) isShown(wgt *commonWidget) bool {
+	if _, ok := wgt.kind.(*keyHintWidget); ok && wgt.Value == "" {
+		return false
+	}
+	return wgt.page == "" || w.activePage == nil || wgt.page == w.activePage()
+}
+
+// shownWidgets returns the widgets which are global or belong to the active page.
+func (w *widget) shownWidgets() []*commonWidget {
+	shown := make([]*commonWidget, 0, len(w.widgets))
+	for _, wgt := range w.widgets {
+		if w.isShown(wgt) {
+			shown = append(shown, wgt)
+		}
+	}
+	return shown
+}
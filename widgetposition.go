@@ -0,0 +1,135 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WidgetPosition is the place of the widget bar in the frame.
+type WidgetPosition int
+
+const (
+	// WidgetsBottom renders the widgets at the edge opposite to the header, this is the default.
+	WidgetsBottom WidgetPosition = iota
+	// WidgetsTop renders the widgets directly under the header, the bottom border keeps the status message.
+	WidgetsTop
+	// WidgetsBoth renders the widgets moved by SetWidgetOnTop under the header and the others at the bottom,
+	// e.g. the stats up top and the key hints at the bottom.
+	WidgetsBoth
+)
+
+// SetWidgetPosition sets the place of the widget bar. The top bar is placed next to the header, so it is
+// above the header when the header is at the bottom. The status bar, the compact footer and the custom
+// widget renderers are always rendered at the bottom.
+func (s *Skeleton) SetWidgetPosition(position WidgetPosition) *Skeleton {
+	s.widget.position = position
+	s.widget.calculateWidgetLength()
+	s.updater.Update()
+	return s
+}
+
+// GetWidgetPosition returns the place of the widget bar.
+func (s *Skeleton) GetWidgetPosition() WidgetPosition {
+	return s.widget.position
+}
+
+// SetWidgetOnTop moves the widget by the given key to the top bar while the widget position is WidgetsBoth.
+func (s *Skeleton) SetWidgetOnTop(key string, top bool) *Skeleton {
+	key = s.normalizeKey(key)
+	if wgt := s.widget.GetWidget(key); wgt != nil {
+		wgt.top = top
+		s.widget.calculateWidgetLength()
+	}
+	s.updater.Update()
+	return s
+}
+
+// IsWidgetOnTop returns the widget by the given key is moved to the top bar or not.
+func (s *Skeleton) IsWidgetOnTop(key string) bool {
+	key = s.normalizeKey(key)
+	if wgt := s.widget.GetWidget(key); wgt != nil {
+		return wgt.top
+	}
+	return false
+}
+
+// isOnTop returns true if the widget is rendered in the top bar.
+func (w *widget) isOnTop(wgt *commonWidget) bool {
+	switch w.position {
+	case WidgetsTop:
+		return true
+	case WidgetsBoth:
+		return wgt.top
+	}
+	return false
+}
+
+// layoutBars splits the shown widgets into the rows of the top and the bottom bar, and reports the
+// overflow of both of them at once. The bottom bar always has a row, it is the bottom border of the frame.
+func (w *widget) layoutBars() {
+	var top, bottom []*commonWidget
+	for _, wgt := range w.shownWidgets() {
+		if w.isOnTop(wgt) {
+			top = append(top, wgt)
+		} else {
+			bottom = append(bottom, wgt)
+		}
+	}
+
+	var kept []*commonWidget
+	var dropped []string
+	w.topRows = nil
+	if len(top) > 0 {
+		w.topRows, kept, dropped = w.layoutRows(top)
+	}
+	rows, keptBottom, droppedBottom := w.layoutRows(bottom)
+	w.rows = rows
+	w.reportOverflow(append(kept, keptBottom...), append(dropped, droppedBottom...))
+}
+
+// topView renders the top bar, it is empty if there are no widgets on top. The rows are joined to the
+// side borders, the status message stays on the bottom border.
+func (w *widget) topView() string {
+	if !w.termReady || w.renderer != nil || w.compact || w.statusBar != nil || w.hidden || len(w.topRows) == 0 || w.hasPageFooter() {
+		w.topHitBoxes = nil
+		return ""
+	}
+
+	// the rows record their hit boxes into hitBoxes, they are kept apart from the bottom bar
+	bottom := w.hitBoxes
+	w.hitBoxes = nil
+	views := make([]string, len(w.topRows))
+	for i, row := range w.topRows {
+		views[i] = w.rowView(row, i, false)
+	}
+	w.topHitBoxes, w.hitBoxes = w.hitBoxes, bottom
+	return lipgloss.JoinVertical(lipgloss.Left, views...)
+}
+
+// topBarHeight returns the rendered height of the top bar.
+func (s *Skeleton) topBarHeight() int {
+	if view := s.widget.topView(); view != "" {
+		return lipgloss.Height(view)
+	}
+	return 0
+}
+
+// topBarRowAt returns the row of the top bar at the given row of the terminal, the rows are mirrored
+// when the header is at the bottom. It returns false if the given row is not in the top bar.
+func (s *Skeleton) topBarRowAt(y int) (int, bool) {
+	height := s.topBarHeight()
+	if height == 0 {
+		return 0, false
+	}
+
+	// the subtitle line is between the header and the bar
+	edge := s.headerHeight() + s.subtitleHeight()
+	line := y - edge
+	if s.isHeaderAtBottom() {
+		// the bar is flipped above the header, its first line is the last one
+		line = height - 1 - (y - (s.viewport.Height - edge - height))
+	}
+	if line < 0 || line >= height {
+		return 0, false
+	}
+	return line / footerRowHeight, true
+}
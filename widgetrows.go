@@ -0,0 +1,116 @@
+package skeleton
+
+import (
+	"slices"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// footerRowHeight is the rendered height of a footer row, the widgets have a border above and below the line.
+const footerRowHeight = 3
+
+// SetWidgetRows sets the number of the footer rows the widgets are split into. Zero wraps the widgets
+// into more rows when they do not fit on a single row, this is the default. The wrapped rows take at most
+// a third of the terminal height, the widgets which do not fit them are truncated or dropped by the overflow policy.
+func (s *Skeleton) SetWidgetRows(rows int) *Skeleton {
+	s.widget.rowCount = max(rows, 0)
+	s.widget.calculateWidgetLength()
+	s.updater.Update()
+	return s
+}
+
+// GetWidgetRows returns the fixed number of the footer rows, zero if the widgets are wrapped automatically.
+func (s *Skeleton) GetWidgetRows() int {
+	return s.widget.rowCount
+}
+
+// widgetWidth returns the rendered width of the given widget.
+func (w *widget) widgetWidth(wgt *commonWidget) int {
+	return ansi.StringWidth(wgt.Value) + w.chromeWidth()
+}
+
+// chromeWidth returns the width a widget takes besides its value, the padding and the borders.
+func (w *widget) chromeWidth() int {
+	return w.properties.leftTabPadding + w.properties.rightTabPadding + 2 // for the borders
+}
+
+// layoutRows splits the given widgets into the rows of a bar. The widgets which do not fit are truncated or
+// dropped by the overflow policy, it returns the kept widgets and the keys of the dropped ones besides the rows.
+// The rows are nil only if the terminal is too narrow for the frame itself.
+func (w *widget) layoutRows(shown []*commonWidget) ([][]*commonWidget, []*commonWidget, []string) {
+	available := w.viewport.Width - 2 // for the corners
+	if available < 0 {
+		return nil, nil, nil
+	}
+
+	// a widget wider than the whole row never fits, it is truncated whatever the policy is
+	widgets := make([]*commonWidget, len(shown))
+	for i, wgt := range shown {
+		widgets[i] = w.truncateWidget(w.constrainWidget(wgt), available-w.chromeWidth())
+	}
+
+	var dropped []string
+	for len(widgets) > 0 {
+		if w.overflowPolicy == WidgetOverflowTruncate {
+			widgets = w.shrinkWidgets(widgets)
+		}
+		if rows := w.splitRows(widgets); rows != nil {
+			return rows, widgets, dropped
+		}
+		lowest := lowestPriority(widgets)
+		dropped = append(dropped, widgets[lowest].Key)
+		widgets = slices.Delete(widgets, lowest, lowest+1)
+	}
+
+	return [][]*commonWidget{nil}, nil, dropped
+}
+
+// autoRowLimit returns the number of the rows the widgets are wrapped into at most without a fixed row count,
+// the rows take at most a third of the terminal height and there is always one.
+func (w *widget) autoRowLimit() int {
+	return max(w.viewport.Height/3/footerRowHeight, 1)
+}
+
+// splitRows splits the given widgets into the footer rows. With a fixed row count the widgets are spread
+// evenly, otherwise a new row is started when the next widget does not fit, up to autoRowLimit rows.
+// It returns nil if they do not fit.
+func (w *widget) splitRows(widgets []*commonWidget) [][]*commonWidget {
+	available := w.viewport.Width - 2 // for the corners
+
+	if w.rowCount > 0 {
+		perRow := (len(widgets) + w.rowCount - 1) / w.rowCount
+		var rows [][]*commonWidget
+		for start := 0; start < len(widgets); start += perRow {
+			row := widgets[start:min(start+perRow, len(widgets))]
+			width := 0
+			for _, wgt := range row {
+				width += w.widgetWidth(wgt)
+			}
+			if width > available {
+				return nil
+			}
+			rows = append(rows, row)
+		}
+		return rows
+	}
+
+	var rows [][]*commonWidget
+	var row []*commonWidget
+	width := 0
+	for _, wgt := range widgets {
+		wgtWidth := w.widgetWidth(wgt)
+		if wgtWidth > available {
+			return nil
+		}
+		if width+wgtWidth > available {
+			if len(rows)+1 >= w.autoRowLimit() {
+				return nil
+			}
+			rows = append(rows, row)
+			row, width = nil, 0
+		}
+		row = append(row, wgt)
+		width += wgtWidth
+	}
+	return append(rows, row)
+}
@@ -0,0 +1,50 @@
+package skeleton
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// addNumberedWidgets adds the given number of widgets whose keys are w0, w1... and whose priorities are their numbers.
+func addNumberedWidgets(s *Skeleton, count int) {
+	for i := range count {
+		key := fmt.Sprintf("w%d", i)
+		s.AddWidget(key, fmt.Sprintf("widget value %d", i))
+		s.SetWidgetPriority(key, i)
+	}
+}
+
+func TestAutomaticWidgetRowsLeaveRoomForBody(t *testing.T) {
+	s := NewSkeleton()
+	s.AddPage("tall", "Tall", tallPage{lines: 100})
+	addNumberedWidgets(s, 40)
+	updateSync(s, tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	if got := len(s.widget.rows); got != 2 {
+		t.Errorf("widgets are wrapped into %d rows, want 2", got)
+	}
+	if view := s.RenderSnapshot(80, 24); !strings.Contains(view, "line 1") {
+		t.Errorf("the footer clips the page body:\n%s", view)
+	}
+}
+
+func TestDefaultWidgetRowsDropLowestPriority(t *testing.T) {
+	s := NewSkeleton()
+	s.AddPage("page", "Page", newTestPage())
+	addNumberedWidgets(s, 40)
+	updateSync(s, tea.WindowSizeMsg{Width: 80, Height: 24})
+
+	dropped := s.GetWidgetOverflowState().Dropped
+	if len(dropped) == 0 {
+		t.Fatal("no widget is dropped")
+	}
+	for i, key := range dropped {
+		if want := fmt.Sprintf("w%d", i); key != want {
+			t.Errorf("dropped %v, want the lowest priorities first", dropped)
+			break
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package skeleton
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// SetWidgetWidth fixes the width of the value of the widget by the given key, shorter values are padded
+// and longer ones are truncated, so rapidly changing values like timers and counters do not shift the
+// footer. Zero removes the constraint.
+func (s *Skeleton) SetWidgetWidth(key string, width int) *Skeleton {
+	return s.SetWidgetWidthRange(key, width, width)
+}
+
+// SetWidgetWidthRange constrains the width of the value of the widget by the given key. Values narrower than
+// minWidth are padded, wider than maxWidth are truncated, or scrolled if the widget is a marquee.
+// Zero leaves that side unconstrained.
+func (s *Skeleton) SetWidgetWidthRange(key string, minWidth int, maxWidth int) *Skeleton {
+	key = s.normalizeKey(key)
+	if wgt := s.widget.GetWidget(key); wgt != nil {
+		wgt.minWidth, wgt.maxWidth = max(minWidth, 0), max(maxWidth, 0)
+		if wgt.maxWidth > 0 {
+			wgt.minWidth = min(wgt.minWidth, wgt.maxWidth)
+		}
+		s.widget.calculateWidgetLength()
+	}
+	s.updater.Update()
+	return s
+}
+
+// GetWidgetWidthRange returns the width constraints of the value of the widget by the given key, zero is unconstrained.
+func (s *Skeleton) GetWidgetWidthRange(key string) (minWidth int, maxWidth int) {
+	key = s.normalizeKey(key)
+	if wgt := s.widget.GetWidget(key); wgt != nil {
+		return wgt.minWidth, wgt.maxWidth
+	}
+	return 0, 0
+}
+
+// constrainWidget returns the given widget, or a copy of it whose value is padded or truncated to its width constraints.
+func (w *widget) constrainWidget(wgt *commonWidget) *commonWidget {
+	if wgt.maxWidth > 0 {
+		wgt = w.truncateWidget(wgt, wgt.maxWidth)
+	}
+	if width := ansi.StringWidth(wgt.Value); width < wgt.minWidth {
+		padded := *wgt
+		padded.Value += strings.Repeat(" ", wgt.minWidth-width)
+		return &padded
+	}
+	return wgt
+}
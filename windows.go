@@ -0,0 +1,134 @@
+package skeleton
+
+import (
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+
+	teakey "github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WindowsCompatibility controls the workarounds for the Windows consoles.
+type WindowsCompatibility int
+
+const (
+	// WindowsCompatibilityAuto enables the workarounds when the application runs on Windows.
+	WindowsCompatibilityAuto WindowsCompatibility = iota
+	// WindowsCompatibilityOn always enables the workarounds, e.g. for a Windows console over SSH.
+	WindowsCompatibilityOn
+	// WindowsCompatibilityOff disables the workarounds.
+	WindowsCompatibilityOff
+)
+
+// WindowsHost is the Windows console host the application runs in.
+type WindowsHost int
+
+const (
+	// WindowsHostNone is not a Windows console, or the workarounds are disabled.
+	WindowsHostNone WindowsHost = iota
+	// WindowsHostTerminal is Windows Terminal, which renders like the other modern terminals.
+	WindowsHostTerminal
+	// WindowsHostConhost is the classic console host. Its default fonts have no rounded corners,
+	// and writing the last column wraps the line.
+	WindowsHostConhost
+)
+
+// windowsTerminalEnv is set by Windows Terminal in the sessions it starts.
+const windowsTerminalEnv = "WT_SESSION"
+
+// roundedCorners replaces the rounded corners, which the conhost fonts can not render, with square ones.
+var roundedCorners = strings.NewReplacer("╭", "┌", "╮", "┐", "╰", "└", "╯", "┘")
+
+// windowsTabKeys are the alternative keys of the tab bindings on Windows. Ctrl+arrows are used for
+// word navigation by the consoles and they are not always reported with their modifiers.
+var windowsTabKeys = map[string]string{
+	keymapSwitchTabRight: "ctrl+pgdown",
+	keymapSwitchTabLeft:  "ctrl+pgup",
+	keymapMovePageRight:  "ctrl+shift+pgdown",
+	keymapMovePageLeft:   "ctrl+shift+pgup",
+}
+
+// SetWindowsCompatibility sets the workarounds for the Windows consoles. While they are enabled:
+//   - the conhost frame and tabs are drawn with square corners,
+//   - the conhost body is rendered one column narrower, so the last column never wraps,
+//   - the default tab switching and moving keys also accept ctrl+pgup and ctrl+pgdown (with shift to move).
+//
+// The default is WindowsCompatibilityAuto.
+func (s *Skeleton) SetWindowsCompatibility(mode WindowsCompatibility) *Skeleton {
+	s.properties.windowsCompatibility = mode
+	s.applyGlyphs()
+	s.applyWindowsKeys()
+	s.updater.UpdateWithMsg(screenModeMsg{})
+	return s
+}
+
+// GetWindowsCompatibility returns the workarounds for the Windows consoles mode.
+func (s *Skeleton) GetWindowsCompatibility() WindowsCompatibility {
+	return s.properties.windowsCompatibility
+}
+
+// GetWindowsHost returns the detected Windows console host, WindowsHostNone if the workarounds are disabled.
+func (s *Skeleton) GetWindowsHost() WindowsHost {
+	switch s.properties.windowsCompatibility {
+	case WindowsCompatibilityOff:
+		return WindowsHostNone
+	case WindowsCompatibilityAuto:
+		if runtime.GOOS != "windows" {
+			return WindowsHostNone
+		}
+	}
+	return detectWindowsHost()
+}
+
+// detectWindowsHost detects the Windows console host from the environment.
+func detectWindowsHost() WindowsHost {
+	if os.Getenv(windowsTerminalEnv) != "" || os.Getenv("TERM_PROGRAM") != "" {
+		return WindowsHostTerminal
+	}
+	return WindowsHostConhost
+}
+
+// squareGlyphs returns the glyph set with the rounded corners replaced by square ones.
+func squareGlyphs(glyphs GlyphSet) GlyphSet {
+	for _, border := range []*lipgloss.Border{&glyphs.Frame, &glyphs.ActiveTab, &glyphs.InactiveTab, &glyphs.DisabledTab, &glyphs.Widget} {
+		border.TopLeft = roundedCorners.Replace(border.TopLeft)
+		border.TopRight = roundedCorners.Replace(border.TopRight)
+		border.BottomLeft = roundedCorners.Replace(border.BottomLeft)
+		border.BottomRight = roundedCorners.Replace(border.BottomRight)
+	}
+	return glyphs
+}
+
+// applyWindowsCompatibility applies the workarounds of the detected Windows console host,
+// it is called when the program starts.
+func (s *Skeleton) applyWindowsCompatibility() {
+	if s.GetWindowsHost() == WindowsHostConhost {
+		s.applyGlyphs()
+	}
+	s.applyWindowsKeys()
+}
+
+// applyWindowsKeys adds or removes the Windows alternatives of the tab bindings. Bindings which are
+// changed by the application are kept as they are.
+func (s *Skeleton) applyWindowsKeys() {
+	enabled := s.GetWindowsHost() != WindowsHostNone
+	for _, binding := range []*teakey.Binding{&s.KeyMap.SwitchTabRight, &s.KeyMap.SwitchTabLeft, &s.KeyMap.MovePageRight, &s.KeyMap.MovePageLeft} {
+		keys := binding.Keys()
+		if len(keys) == 0 {
+			continue
+		}
+		alternative, ok := windowsTabKeys[keys[0]]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case enabled && slices.Equal(keys, []string{keys[0]}):
+			binding.SetKeys(keys[0], alternative)
+		case !enabled && slices.Equal(keys, []string{keys[0], alternative}):
+			binding.SetKeys(keys[0])
+		}
+	}
+}
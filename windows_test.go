@@ -0,0 +1,129 @@
+package skeleton
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestSquareGlyphs(t *testing.T) {
+	tests := []struct {
+		name   string
+		glyphs GlyphSet
+	}{
+		{name: "unicode", glyphs: UnicodeGlyphs()},
+		{name: "nerd font", glyphs: NerdFontGlyphs()},
+		{name: "ascii", glyphs: ASCIIGlyphs()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := tt.glyphs
+			squared := squareGlyphs(tt.glyphs)
+
+			borders := map[string][2]lipgloss.Border{
+				"frame":        {original.Frame, squared.Frame},
+				"active tab":   {original.ActiveTab, squared.ActiveTab},
+				"inactive tab": {original.InactiveTab, squared.InactiveTab},
+				"disabled tab": {original.DisabledTab, squared.DisabledTab},
+				"widget":       {original.Widget, squared.Widget},
+			}
+			for name, pair := range borders {
+				before, after := pair[0], pair[1]
+				for _, corner := range []string{after.TopLeft, after.TopRight, after.BottomLeft, after.BottomRight} {
+					if strings.ContainsAny(corner, "╭╮╰╯") {
+						t.Errorf("%s keeps the rounded corner %q", name, corner)
+					}
+				}
+				if before.Top != after.Top || before.Left != after.Left || before.Right != after.Right || before.Bottom != after.Bottom {
+					t.Errorf("%s changed its edges", name)
+				}
+			}
+			if original.Frame != tt.glyphs.Frame {
+				t.Error("the given glyph set was changed")
+			}
+		})
+	}
+
+	if got := squareGlyphs(UnicodeGlyphs()).Frame.TopLeft; got != "┌" {
+		t.Errorf("rounded top left corner became %q, want %q", got, "┌")
+	}
+}
+
+func TestApplyWindowsKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		mode WindowsCompatibility
+		want []string
+	}{
+		{name: "on", mode: WindowsCompatibilityOn, want: []string{keymapSwitchTabRight, "ctrl+pgdown"}},
+		{name: "off", mode: WindowsCompatibilityOff, want: []string{keymapSwitchTabRight}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSkeleton().SetWindowsCompatibility(tt.mode)
+			if got := s.KeyMap.SwitchTabRight.Keys(); !slices.Equal(got, tt.want) {
+				t.Errorf("switch tab right keys are %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("disable after enable", func(t *testing.T) {
+		s := NewSkeleton().SetWindowsCompatibility(WindowsCompatibilityOn).SetWindowsCompatibility(WindowsCompatibilityOff)
+		for _, binding := range [][]string{s.KeyMap.SwitchTabRight.Keys(), s.KeyMap.SwitchTabLeft.Keys(), s.KeyMap.MovePageRight.Keys(), s.KeyMap.MovePageLeft.Keys()} {
+			if len(binding) != 1 {
+				t.Errorf("keys are %v, want the default key only", binding)
+			}
+		}
+	})
+
+	t.Run("changed bindings are kept", func(t *testing.T) {
+		s := NewSkeleton()
+		s.KeyMap.SwitchTabRight.SetKeys("alt+l")
+		s.KeyMap.SwitchTabLeft.SetKeys(keymapSwitchTabLeft, "alt+h")
+
+		s.SetWindowsCompatibility(WindowsCompatibilityOn)
+		if got := s.KeyMap.SwitchTabRight.Keys(); !slices.Equal(got, []string{"alt+l"}) {
+			t.Errorf("enabling changed the switch tab right keys to %v", got)
+		}
+		if got := s.KeyMap.SwitchTabLeft.Keys(); !slices.Equal(got, []string{keymapSwitchTabLeft, "alt+h"}) {
+			t.Errorf("enabling changed the switch tab left keys to %v", got)
+		}
+
+		s.SetWindowsCompatibility(WindowsCompatibilityOff)
+		if got := s.KeyMap.SwitchTabLeft.Keys(); !slices.Equal(got, []string{keymapSwitchTabLeft, "alt+h"}) {
+			t.Errorf("disabling changed the switch tab left keys to %v", got)
+		}
+	})
+}
+
+func TestDetectWindowsHost(t *testing.T) {
+	tests := []struct {
+		name        string
+		mode        WindowsCompatibility
+		wtSession   string
+		termProgram string
+		want        WindowsHost
+	}{
+		{name: "windows terminal", mode: WindowsCompatibilityOn, wtSession: "0f1e2d3c", want: WindowsHostTerminal},
+		{name: "other terminal", mode: WindowsCompatibilityOn, termProgram: "vscode", want: WindowsHostTerminal},
+		{name: "conhost", mode: WindowsCompatibilityOn, want: WindowsHostConhost},
+		{name: "off in windows terminal", mode: WindowsCompatibilityOff, wtSession: "0f1e2d3c", want: WindowsHostNone},
+		{name: "off in conhost", mode: WindowsCompatibilityOff, want: WindowsHostNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(windowsTerminalEnv, tt.wtSession)
+			t.Setenv("TERM_PROGRAM", tt.termProgram)
+
+			s := NewSkeleton().SetWindowsCompatibility(tt.mode)
+			if got := s.GetWindowsHost(); got != tt.want {
+				t.Errorf("host is %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
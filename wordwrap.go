@@ -0,0 +1,33 @@
+package skeleton
+
+import (
+	"github.com/charmbracelet/x/ansi"
+)
+
+// SetPageWordWrap reflows the output of the page by the given key to the content width, so long
+// paragraphs are wrapped at the spaces instead of overflowing the frame. Words longer than the width are
+// broken, the escape sequences are kept intact. It is applied after the view processors.
+func (s *Skeleton) SetPageWordWrap(key string, wrap bool) *Skeleton {
+	key = s.normalizeKey(key)
+	if wrap {
+		s.pageWordWrap[key] = true
+	} else {
+		delete(s.pageWordWrap, key)
+	}
+	s.updater.Update()
+	return s
+}
+
+// GetPageWordWrap returns the output of the page by the given key is reflowed to the content width or not.
+func (s *Skeleton) GetPageWordWrap(key string) bool {
+	key = s.normalizeKey(key)
+	return s.pageWordWrap[key]
+}
+
+// wrapView reflows the view of the page by the given key to the content width if the page wraps.
+func (s *Skeleton) wrapView(key string, view string) string {
+	if !s.pageWordWrap[key] {
+		return view
+	}
+	return ansi.Wrap(view, max(s.GetContentWidth(), 1), "")
+}
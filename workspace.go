@@ -0,0 +1,136 @@
+package skeleton
+
+import (
+	"fmt"
+)
+
+// SetTabWorkspace puts the tab by the given key into the named workspace (tab group). While a workspace
+// is active, the header shows only its tabs and the tabs without a workspace. An empty name removes
+// the tab from its workspace.
+func (s *Skeleton) SetTabWorkspace(key string, workspace string) *Skeleton {
+	key = s.normalizeKey(key)
+	if workspace == "" {
+		delete(s.header.tabWorkspaces, key)
+	} else {
+		s.header.tabWorkspaces[key] = workspace
+	}
+	s.header.calculateTitleLength()
+	s.updater.Update()
+	return s
+}
+
+// GetTabWorkspace returns the workspace of the tab by the given key, it is empty if the tab has none.
+func (s *Skeleton) GetTabWorkspace(key string) string {
+	key = s.normalizeKey(key)
+	return s.header.tabWorkspaces[key]
+}
+
+// GetWorkspaces returns the names of the workspaces in the order of their first tabs.
+func (s *Skeleton) GetWorkspaces() []string {
+	var workspaces []string
+	seen := make(map[string]bool)
+	for _, hdr := range s.header.headers {
+		workspace := s.header.tabWorkspaces[hdr.key]
+		if workspace != "" && !seen[workspace] {
+			seen[workspace] = true
+			workspaces = append(workspaces, workspace)
+		}
+	}
+	return workspaces
+}
+
+// GetActiveWorkspace returns the name of the active workspace, it is empty if all tabs are shown.
+func (s *Skeleton) GetActiveWorkspace() string {
+	return s.header.activeWorkspace
+}
+
+// SetActiveWorkspace shows only the tabs of the named workspace, an empty name shows all tabs. If the
+// active tab is hidden, the last active tab of the workspace is activated. It returns false if the
+// workspace has no tabs.
+func (s *Skeleton) SetActiveWorkspace(workspace string) bool {
+	if workspace != "" && !s.hasWorkspace(workspace) {
+		return false
+	}
+
+	s.header.activeWorkspace = workspace
+	s.header.calculateTitleLength()
+	s.Announce(fmt.Sprintf("Workspace %s", workspace))
+
+	if !s.header.isVisible(s.currentTab) {
+		s.setCurrentTab(s.workspaceTab(workspace))
+	}
+	s.updater.Update()
+	return true
+}
+
+// NextWorkspace activates the next workspace, it wraps around after the last one.
+func (s *Skeleton) NextWorkspace() *Skeleton {
+	workspaces := s.GetWorkspaces()
+	if len(workspaces) == 0 {
+		return s
+	}
+
+	next := 0
+	for i, workspace := range workspaces {
+		if workspace == s.header.activeWorkspace {
+			next = (i + 1) % len(workspaces)
+		}
+	}
+	s.SetActiveWorkspace(workspaces[next])
+	return s
+}
+
+// hasWorkspace returns true if a tab is in the named workspace.
+func (s *Skeleton) hasWorkspace(workspace string) bool {
+	for _, hdr := range s.header.headers {
+		if s.header.tabWorkspaces[hdr.key] == workspace {
+			return true
+		}
+	}
+	return false
+}
+
+// workspaceTab returns the index of the tab to activate in the named workspace: its last active
+// tab if it is still there, otherwise the first visible tab.
+func (s *Skeleton) workspaceTab(workspace string) int {
+	if index := s.pageIndex(s.workspaceTabs[workspace]); index >= 0 && s.header.isVisible(index) {
+		return index
+	}
+	for i := range s.header.headers {
+		if s.header.isVisible(i) {
+			return i
+		}
+	}
+	return s.currentTab
+}
+
+// rememberWorkspaceTab records the tab at the given index as the last active tab of its workspace.
+func (s *Skeleton) rememberWorkspaceTab(index int) {
+	key := s.header.headers[index].key
+	if workspace := s.header.tabWorkspaces[key]; workspace != "" {
+		s.workspaceTabs[workspace] = key
+	}
+}
+
+// isVisible returns true if the tab at the given index is shown in the active workspace.
+func (h *header) isVisible(i int) bool {
+	if h.activeWorkspace == "" || i < 0 || i >= len(h.headers) {
+		return true
+	}
+	workspace := h.tabWorkspaces[h.headers[i].key]
+	return workspace == "" || workspace == h.activeWorkspace
+}
+
+// visibleIndex returns the index of the nth (0-based) visible tab, or -1 if there is no such tab.
+func (h *header) visibleIndex(n int) int {
+	for i := range h.headers {
+		if !h.isVisible(i) {
+			continue
+		}
+		if n == 0 {
+			return i
+		}
+		n--
+	}
+	return -1
+}